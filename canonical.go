@@ -0,0 +1,78 @@
+package kyber
+
+import "errors"
+
+// Each group marshals its scalars in its own byte order: edwards25519 is
+// little-endian while the nist groups are big-endian. CanonicalScalarBytes and
+// SetCanonicalScalarBytes give a single convention, big-endian with the fixed
+// width of the group's scalars, to use when scalars of different groups are
+// mixed, for example in the leaves of a Merkle tree.
+
+// CanonicalScalarBytes returns the big-endian encoding of s, padded to the
+// width of the scalars of g, whatever the native byte order of g.
+func CanonicalScalarBytes(g Group, s Scalar) ([]byte, error) {
+	buf, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	little, err := littleEndian(g)
+	if err != nil {
+		return nil, err
+	}
+	if little {
+		reverse(buf)
+	}
+	return buf, nil
+}
+
+// SetCanonicalScalarBytes returns a new scalar of g decoded from the
+// encoding produced by CanonicalScalarBytes. It returns an error if buf does
+// not have the width of the scalars of g or if the value is not reduced
+// modulo the order of g.
+func SetCanonicalScalarBytes(g Group, buf []byte) (Scalar, error) {
+	if len(buf) != g.ScalarLen() {
+		return nil, errors.New("wrong size buffer")
+	}
+	little, err := littleEndian(g)
+	if err != nil {
+		return nil, err
+	}
+	native := make([]byte, len(buf))
+	copy(native, buf)
+	if little {
+		reverse(native)
+	}
+	s := g.Scalar()
+	if !s.IsCanonical(native) {
+		return nil, errors.New("scalar value out of range")
+	}
+	if err := s.UnmarshalBinary(native); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// littleEndian tells the native byte order of the scalars of g from the
+// position of the lowest byte in the encoding of one.
+func littleEndian(g Group) (bool, error) {
+	buf, err := g.Scalar().One().MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	return len(buf) > 1 && buf[0] == 1, nil
+}
+
+// ReverseBytes returns a copy of b with its bytes in reverse order, which
+// converts an encoding between little-endian and big-endian.
+func ReverseBytes(b []byte) []byte {
+	r := make([]byte, len(b))
+	copy(r, b)
+	reverse(r)
+	return r
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
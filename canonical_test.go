@@ -0,0 +1,67 @@
+package kyber_test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalScalarBytes(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+
+	buf, err := kyber.CanonicalScalarBytes(suite, suite.Scalar().SetInt64(0x0102))
+	require.Nil(t, err)
+	require.Equal(t, suite.ScalarLen(), len(buf))
+	require.Equal(t, []byte{1, 2}, buf[len(buf)-2:])
+
+	for i := 0; i < 100; i++ {
+		s := suite.Scalar().Pick(random.Stream)
+		buf, err := kyber.CanonicalScalarBytes(suite, s)
+		require.Nil(t, err)
+		s2, err := kyber.SetCanonicalScalarBytes(suite, buf)
+		require.Nil(t, err)
+		require.True(t, s.Equal(s2))
+	}
+
+	_, err = kyber.SetCanonicalScalarBytes(suite, buf[1:])
+	require.Error(t, err)
+
+	// the order of the group is not a canonical scalar
+	order := []byte{
+		0x10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x14, 0xde, 0xf9, 0xde, 0xa2, 0xf7, 0x9c, 0xd6,
+		0x58, 0x12, 0x63, 0x1a, 0x5c, 0xf5, 0xd3, 0xed,
+	}
+	_, err = kyber.SetCanonicalScalarBytes(suite, order)
+	require.Error(t, err)
+}
+
+func TestScalarEndianBytes(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	s := suite.Scalar().SetInt64(0x010203)
+
+	little := s.LittleEndianBytes()
+	big := s.BigEndianBytes()
+	require.Equal(t, suite.ScalarLen(), len(little))
+	require.Equal(t, suite.ScalarLen(), len(big))
+	require.Equal(t, []byte{3, 2, 1, 0}, little[:4])
+	require.Equal(t, []byte{0, 1, 2, 3}, big[len(big)-4:])
+	require.Equal(t, big, kyber.ReverseBytes(little))
+
+	native, err := s.MarshalBinary()
+	require.Nil(t, err)
+	require.Equal(t, native, little)
+	canonical, err := kyber.CanonicalScalarBytes(suite, s)
+	require.Nil(t, err)
+	require.Equal(t, canonical, big)
+}
+
+func TestReverseBytes(t *testing.T) {
+	b := []byte{1, 2, 3}
+	require.Equal(t, []byte{3, 2, 1}, kyber.ReverseBytes(b))
+	require.Equal(t, []byte{1, 2, 3}, b)
+	require.Equal(t, []byte{}, kyber.ReverseBytes([]byte{}))
+}
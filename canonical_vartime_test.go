@@ -0,0 +1,44 @@
+// +build vartime
+
+package kyber_test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/nist"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalScalarBytesAcrossGroups(t *testing.T) {
+	ed := edwards25519.NewAES128SHA256Ed25519()
+	p256 := nist.NewAES128SHA256P256()
+	require.Equal(t, ed.ScalarLen(), p256.ScalarLen())
+
+	for _, v := range []int64{0, 1, 0x0102, 0x7fffffffffffffff} {
+		b1, err := kyber.CanonicalScalarBytes(ed, ed.Scalar().SetInt64(v))
+		require.Nil(t, err)
+		b2, err := kyber.CanonicalScalarBytes(p256, p256.Scalar().SetInt64(v))
+		require.Nil(t, err)
+		require.Equal(t, b1, b2)
+
+		s, err := kyber.SetCanonicalScalarBytes(p256, b1)
+		require.Nil(t, err)
+		require.True(t, s.Equal(p256.Scalar().SetInt64(v)))
+	}
+}
+
+func TestScalarEndianBytesAcrossGroups(t *testing.T) {
+	ed := edwards25519.NewAES128SHA256Ed25519()
+	p256 := nist.NewAES128SHA256P256()
+	for _, v := range []int64{0, 1, 0x010203} {
+		s1 := ed.Scalar().SetInt64(v)
+		s2 := p256.Scalar().SetInt64(v)
+		require.Equal(t, s1.BigEndianBytes(), s2.BigEndianBytes())
+		require.Equal(t, s1.LittleEndianBytes(), s2.LittleEndianBytes())
+	}
+	native, err := p256.Scalar().SetInt64(0x010203).MarshalBinary()
+	require.Nil(t, err)
+	require.Equal(t, native, p256.Scalar().SetInt64(0x010203).BigEndianBytes())
+}
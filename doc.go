@@ -57,6 +57,9 @@ Other sub-packages build more interesting high-level cryptographic tools
 atop these kyber.primitive interfaces,
 including:
 
+- encrypt: Public-key encryption, such as deterministic ElGamal encryption
+whose ciphertexts can be compared for equality without decrypting them.
+
 - share: Polynomial commitment and verifiable Shamir secret splitting
 for implementing verifiable 't-of-n' threshold cryptographic schemes.
 This can be used to encrypt a message so that any 2 out of 3 receivers
@@ -1,8 +1,11 @@
 package kyber
 
 import (
+	"bytes"
 	"crypto/cipher"
 	"encoding"
+	"encoding/binary"
+	"errors"
 	"io"
 )
 
@@ -93,3 +96,45 @@ type Encoding interface {
 	// Read and decode objects from an io.Reader.
 	Read(r io.Reader, objs ...interface{}) error
 }
+
+// MarshalPoints encodes a slice of points into a single buffer: the number of
+// points as a 32-bit big-endian integer, followed by the concatenation of the
+// fixed-width binary encodings of the points.
+func MarshalPoints(points []Point) ([]byte, error) {
+	size := 4
+	for _, p := range points {
+		size += p.MarshalSize()
+	}
+	var b bytes.Buffer
+	b.Grow(size)
+	if err := binary.Write(&b, binary.BigEndian, uint32(len(points))); err != nil {
+		return nil, err
+	}
+	for _, p := range points {
+		if _, err := p.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalPoints decodes n points of the group g encoded with MarshalPoints.
+// It returns an error if data does not hold exactly n points.
+func UnmarshalPoints(g Group, data []byte, n int) ([]Point, error) {
+	if len(data) < 4 || binary.BigEndian.Uint32(data) != uint32(n) {
+		return nil, errors.New("wrong number of points")
+	}
+	size := g.PointLen()
+	data = data[4:]
+	if len(data) != n*size {
+		return nil, errors.New("wrong size buffer")
+	}
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = g.Point()
+		if err := points[i].UnmarshalBinary(data[i*size : (i+1)*size]); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
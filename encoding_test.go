@@ -0,0 +1,51 @@
+package kyber_test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalPoints(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 10
+	points := make([]kyber.Point, n)
+	for i := range points {
+		points[i] = suite.Point().Pick(random.Stream)
+	}
+	buf, err := kyber.MarshalPoints(points)
+	require.Nil(t, err)
+	require.Equal(t, 4+n*suite.PointLen(), len(buf))
+
+	decoded, err := kyber.UnmarshalPoints(suite, buf, n)
+	require.Nil(t, err)
+	require.Equal(t, n, len(decoded))
+	for i := range points {
+		require.True(t, points[i].Equal(decoded[i]))
+	}
+
+	buf, err = kyber.MarshalPoints(nil)
+	require.Nil(t, err)
+	decoded, err = kyber.UnmarshalPoints(suite, buf, 0)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(decoded))
+}
+
+func TestUnmarshalPointsTruncated(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	points := []kyber.Point{suite.Point().Base(), suite.Point().Null()}
+	buf, err := kyber.MarshalPoints(points)
+	require.Nil(t, err)
+
+	_, err = kyber.UnmarshalPoints(suite, buf[:len(buf)-1], 2)
+	require.Error(t, err)
+	_, err = kyber.UnmarshalPoints(suite, buf[:3], 2)
+	require.Error(t, err)
+	_, err = kyber.UnmarshalPoints(suite, append(buf, 0), 2)
+	require.Error(t, err)
+	_, err = kyber.UnmarshalPoints(suite, buf, 3)
+	require.Error(t, err)
+}
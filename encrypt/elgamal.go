@@ -0,0 +1,99 @@
+// Package encrypt provides public-key encryption schemes built on the kyber
+// groups.
+package encrypt
+
+import (
+	"crypto/cipher"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/cipher/sha3"
+)
+
+var errorDerivKey = errors.New("encrypt: empty derivation key")
+
+// Ciphertext is an ElGamal ciphertext: the ephemeral key K and the blinded
+// message C.
+type Ciphertext struct {
+	K kyber.Point
+	C kyber.Point
+}
+
+// deterministicDomain separates the derivation of the ephemeral keys from
+// any other use of the derivation key.
+var deterministicDomain = []byte("kyber-deterministic-elgamal")
+
+// DeterministicElGamalEncrypt ElGamal-encrypts the message to the public key
+// like ordinary ElGamal, except that the ephemeral key k and the padding of
+// the embedding are derived from the message and the secret derivation key
+// derivKey with SHAKE256, instead of drawn at random. The message, or as much
+// of it as fits, is embedded into a point, and the part that didn't fit is
+// returned as remainder.
+//
+// Encrypting the same message twice to the same public key with the same
+// derivation key thus yields the same ciphertext (K, C), which makes it
+// possible to test ciphertexts for equality, e.g. to search or deduplicate
+// them, without decrypting. This is also the security tradeoff: anyone seeing
+// the ciphertexts learns which ones hold equal messages, and can confirm a
+// guess of a message it can encrypt itself, so that the scheme is not
+// semantically secure and must not be used for low-entropy messages. The
+// derivation key must be kept secret, as k must be: it should be a dedicated
+// random key, or derived from the private key of the encrypting party.
+func DeterministicElGamalEncrypt(group kyber.Group, pub kyber.Point, msg []byte, derivKey []byte) (K, C kyber.Point, remainder []byte, err error) {
+	// a nil key would make the cipher pick a random one
+	if len(derivKey) == 0 {
+		return nil, nil, nil, errorDerivKey
+	}
+	max := group.Point().EmbedLen()
+	if max > len(msg) {
+		max = len(msg)
+	}
+	embedded, remainder := msg[:max], msg[max:]
+
+	stream := sha3.NewShakeCipher256(derivKey)
+	stream.Message(nil, nil, deterministicDomain)
+	stream.Message(nil, nil, embedded)
+
+	k := group.Scalar().Pick(stream)           // ephemeral private key
+	M := group.Point().Embed(embedded, stream) // message embedded in a point
+	K = group.Point().Mul(k, nil)              // ephemeral DH public key
+	S := group.Point().Mul(k, pub)             // ephemeral DH shared secret
+	C = S.Add(S, M)                            // message blinded with secret
+	return K, C, remainder, nil
+}
+
+// ElGamalDecrypt decrypts the ciphertext (K, C) with the private key and
+// returns the embedded message. It decrypts both deterministic and ordinary
+// ElGamal ciphertexts.
+func ElGamalDecrypt(group kyber.Group, priv kyber.Scalar, K, C kyber.Point) ([]byte, error) {
+	S := group.Point().Mul(priv, K) // regenerate shared secret
+	M := group.Point().Sub(C, S)    // use to un-blind the message
+	return M.Data()
+}
+
+// ReEncrypt re-randomizes the ciphertext (K,C) encrypted to public by adding a
+// fresh ephemeral component: it returns (K+rG, C+rX) for a random r. The
+// result is unlinkable to (K,C) without the private key, and decrypts to the
+// same message.
+func ReEncrypt(group kyber.Group, public, K, C kyber.Point, rand cipher.Stream) (K2, C2 kyber.Point) {
+	r := group.Scalar().Pick(rand)
+	K2 = group.Point().Mul(r, nil)
+	K2.Add(K2, K)
+	C2 = group.Point().Mul(r, public)
+	C2.Add(C2, C)
+	return K2, C2
+}
+
+// AddCiphertexts adds the ciphertexts component-wise. Since ElGamal is
+// additively homomorphic, the result decrypts to the sum of the message
+// points, e.g. to (m1+m2+...)G for messages encrypted in the exponent as miG,
+// so that only the tally needs to be decrypted. The sum of no ciphertexts is
+// an encryption of the neutral element.
+func AddCiphertexts(group kyber.Group, cts []Ciphertext) Ciphertext {
+	sum := Ciphertext{group.Point().Null(), group.Point().Null()}
+	for _, ct := range cts {
+		sum.K.Add(sum.K, ct.K)
+		sum.C.Add(sum.C, ct.C)
+	}
+	return sum
+}
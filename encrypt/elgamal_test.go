@@ -0,0 +1,85 @@
+package encrypt
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeterministicElGamal(t *testing.T) {
+	group := edwards25519.NewAES128SHA256Ed25519()
+	priv := group.Scalar().Pick(random.Stream)
+	pub := group.Point().Mul(priv, nil)
+	derivKey := random.Bits(256, false, random.Stream)
+	msg := []byte("a message")
+
+	K1, C1, rem, err := DeterministicElGamalEncrypt(group, pub, msg, derivKey)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(rem))
+	K2, C2, _, err := DeterministicElGamalEncrypt(group, pub, msg, derivKey)
+	require.Nil(t, err)
+	require.True(t, K1.Equal(K2))
+	require.True(t, C1.Equal(C2))
+
+	dec, err := ElGamalDecrypt(group, priv, K1, C1)
+	require.Nil(t, err)
+	require.Equal(t, msg, dec)
+
+	// different messages or derivation keys give different ciphertexts
+	K3, C3, _, err := DeterministicElGamalEncrypt(group, pub, []byte("another message"), derivKey)
+	require.Nil(t, err)
+	require.False(t, K1.Equal(K3))
+	require.False(t, C1.Equal(C3))
+	K4, C4, _, err := DeterministicElGamalEncrypt(group, pub, msg, random.Bits(256, false, random.Stream))
+	require.Nil(t, err)
+	require.False(t, K1.Equal(K4))
+	require.False(t, C1.Equal(C4))
+
+	// only the embedded part is encrypted
+	long := make([]byte, group.Point().EmbedLen()+5)
+	_, _, rem, err = DeterministicElGamalEncrypt(group, pub, long, derivKey)
+	require.Nil(t, err)
+	require.Equal(t, long[group.Point().EmbedLen():], rem)
+
+	_, _, _, err = DeterministicElGamalEncrypt(group, pub, msg, nil)
+	require.Equal(t, errorDerivKey, err)
+}
+
+func TestReEncrypt(t *testing.T) {
+	group := edwards25519.NewAES128SHA256Ed25519()
+	priv := group.Scalar().Pick(random.Stream)
+	pub := group.Point().Mul(priv, nil)
+	msg := []byte("re-encrypted")
+	K, C, _, err := DeterministicElGamalEncrypt(group, pub, msg, []byte("key"))
+	require.Nil(t, err)
+
+	K2, C2 := ReEncrypt(group, pub, K, C, random.Stream)
+	require.False(t, K2.Equal(K))
+	require.False(t, C2.Equal(C))
+	dec, err := ElGamalDecrypt(group, priv, K2, C2)
+	require.Nil(t, err)
+	require.Equal(t, msg, dec)
+}
+
+func TestAddCiphertexts(t *testing.T) {
+	group := edwards25519.NewAES128SHA256Ed25519()
+	priv := group.Scalar().Pick(random.Stream)
+	pub := group.Point().Mul(priv, nil)
+
+	// messages in the exponent, as re-randomized trivial encryptions (0, mG)
+	cts := make([]Ciphertext, 3)
+	for i, m := range []int64{3, 0, 5} {
+		M := group.Point().Mul(group.Scalar().SetInt64(m), nil)
+		cts[i].K, cts[i].C = ReEncrypt(group, pub, group.Point().Null(), M, random.Stream)
+	}
+	sum := AddCiphertexts(group, cts)
+	S := group.Point().Mul(priv, sum.K)
+	M := group.Point().Sub(sum.C, S)
+	require.True(t, M.Equal(group.Point().Mul(group.Scalar().SetInt64(8), nil)))
+
+	empty := AddCiphertexts(group, nil)
+	require.True(t, empty.K.Equal(group.Point().Null()))
+	require.True(t, empty.C.Equal(group.Point().Null()))
+}
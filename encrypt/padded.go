@@ -0,0 +1,112 @@
+package encrypt
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errorBlockSize = errors.New("encrypt: block size must be positive")
+var errorMessageSize = errors.New("encrypt: message longer than the block size")
+var errorNoEmbedding = errors.New("encrypt: group cannot embed data in its points")
+var errorCiphertextSize = errors.New("encrypt: ciphertext of the wrong size for the block size")
+var errorPadding = errors.New("encrypt: invalid padding")
+
+// paddedBlocks returns the number of points the messages of up to blockSize
+// bytes are embedded into, one byte of padding at least being appended.
+func paddedBlocks(group kyber.Group, blockSize int) (int, error) {
+	if blockSize < 1 {
+		return 0, errorBlockSize
+	}
+	embedLen := group.Point().EmbedLen()
+	if embedLen < 1 {
+		return 0, errorNoEmbedding
+	}
+	return blockSize/embedLen + 1, nil
+}
+
+// EncryptPadded ElGamal-encrypts a message of at most blockSize bytes to the
+// public key, so that the ciphertext has the same size for all the messages
+// up to blockSize bytes and does not reveal their length. The message is
+// padded with a 0x80 byte followed by as many zero bytes as needed to fill
+// blockSize/EmbedLen + 1 points, each fully embedded and encrypted with its
+// own random ephemeral key. The ciphertext is the concatenation of the
+// encoded pairs (K, C) of these points.
+//
+// For the sizes to hide anything, all the parties must use the same block
+// size, which should be the largest length of the messages of the protocol.
+func EncryptPadded(group kyber.Group, pub kyber.Point, msg []byte, blockSize int) ([]byte, error) {
+	n, err := paddedBlocks(group, blockSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg) > blockSize {
+		return nil, errorMessageSize
+	}
+	embedLen := group.Point().EmbedLen()
+	padded := make([]byte, n*embedLen)
+	copy(padded, msg)
+	padded[len(msg)] = 0x80
+
+	var ciphertext []byte
+	for i := 0; i < n; i++ {
+		k := group.Scalar().Pick(random.Stream)
+		M := group.Point().Embed(padded[i*embedLen:(i+1)*embedLen], random.Stream)
+		K := group.Point().Mul(k, nil)
+		S := group.Point().Mul(k, pub)
+		C := S.Add(S, M)
+		for _, P := range []kyber.Point{K, C} {
+			buf, err := P.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			ciphertext = append(ciphertext, buf...)
+		}
+	}
+	return ciphertext, nil
+}
+
+// DecryptPadded decrypts a ciphertext of EncryptPadded with the private key
+// and returns the message without its padding. The block size must be the
+// one used for the encryption.
+func DecryptPadded(group kyber.Group, priv kyber.Scalar, ciphertext []byte, blockSize int) ([]byte, error) {
+	n, err := paddedBlocks(group, blockSize)
+	if err != nil {
+		return nil, err
+	}
+	pointLen := group.PointLen()
+	if len(ciphertext) != 2*n*pointLen {
+		return nil, errorCiphertextSize
+	}
+	embedLen := group.Point().EmbedLen()
+	padded := make([]byte, 0, n*embedLen)
+	for i := 0; i < n; i++ {
+		K := group.Point()
+		C := group.Point()
+		if err := K.UnmarshalBinary(ciphertext[2*i*pointLen : (2*i+1)*pointLen]); err != nil {
+			return nil, err
+		}
+		if err := C.UnmarshalBinary(ciphertext[(2*i+1)*pointLen : (2*i+2)*pointLen]); err != nil {
+			return nil, err
+		}
+		data, err := ElGamalDecrypt(group, priv, K, C)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != embedLen {
+			return nil, errorPadding
+		}
+		padded = append(padded, data...)
+	}
+
+	// strip the zero bytes and the 0x80 byte
+	end := len(padded) - 1
+	for end >= 0 && padded[end] == 0 {
+		end--
+	}
+	if end < 0 || end > blockSize || padded[end] != 0x80 {
+		return nil, errorPadding
+	}
+	return padded[:end], nil
+}
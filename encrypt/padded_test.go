@@ -0,0 +1,51 @@
+package encrypt
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptPadded(t *testing.T) {
+	group := edwards25519.NewAES128SHA256Ed25519()
+	priv := group.Scalar().Pick(random.Stream)
+	pub := group.Point().Mul(priv, nil)
+	blockSize := 64
+
+	var size int
+	for _, msg := range [][]byte{
+		{},
+		[]byte("yes"),
+		[]byte("a longer ballot for the second candidate"),
+		make([]byte, blockSize), // all zeros, the padding must not be confused
+	} {
+		c, err := EncryptPadded(group, pub, msg, blockSize)
+		require.Nil(t, err)
+		if size == 0 {
+			size = len(c)
+		}
+		require.Equal(t, size, len(c))
+
+		dec, err := DecryptPadded(group, priv, c, blockSize)
+		require.Nil(t, err)
+		require.Equal(t, msg, dec)
+	}
+	n := blockSize/group.Point().EmbedLen() + 1
+	require.Equal(t, 2*n*group.PointLen(), size)
+
+	_, err := EncryptPadded(group, pub, make([]byte, blockSize+1), blockSize)
+	require.Equal(t, errorMessageSize, err)
+	_, err = EncryptPadded(group, pub, nil, 0)
+	require.Equal(t, errorBlockSize, err)
+
+	c, err := EncryptPadded(group, pub, []byte("yes"), blockSize)
+	require.Nil(t, err)
+	_, err = DecryptPadded(group, priv, c[1:], blockSize)
+	require.Equal(t, errorCiphertextSize, err)
+	_, err = DecryptPadded(group, priv, c, 2*blockSize)
+	require.Equal(t, errorCiphertextSize, err)
+	_, err = DecryptPadded(group, group.Scalar().Pick(random.Stream), c, blockSize)
+	require.NotNil(t, err)
+}
@@ -41,25 +41,75 @@ type Scalar interface {
 	// Set to the multiplicative identity (1)
 	One() Scalar
 
+	// IsZero returns true if the scalar is the additive identity (0)
+	IsZero() bool
+
+	// IsOne returns true if the scalar is the multiplicative identity (1)
+	IsOne() bool
+
 	// Set to the modular product of scalars a and b
 	Mul(a, b Scalar) Scalar
 
-	// Set to the modular division of scalar a by scalar b
+	// Set to the modular sum of the product a*b and c,
+	// in a single operation
+	MulAdd(a, b, c Scalar) Scalar
+
+	// Set to the modular division of scalar a by scalar b.
+	// Implementations panic if b is zero.
 	Div(a, b Scalar) Scalar
 
-	// Set to the modular inverse of scalar a
+	// Set to the modular inverse of scalar a.
+	// Implementations panic if a is zero.
 	Inv(a Scalar) Scalar
 
 	// Set to a fresh random or pseudo-random scalar
 	Pick(rand cipher.Stream) Scalar
 
-	// SetBytes sets the scalar from a big-endian byte-slice,
+	// SetBytes sets the scalar from a byte-slice,
 	// reducing if necessary to the appropriate modulus.
+	// The byte order depends on the implementation:
+	// edwards25519 reads little-endian, and mod.Int, used by the
+	// nist groups, reads in its byte order BO, big-endian by default.
 	SetBytes([]byte) Scalar
 
-	// Bytes returns a big-Endian representation of the scalar
+	// SetBytesWide sets the scalar to 64 bytes, such as a hash output,
+	// reduced modulo the order. The result is close to uniform as long
+	// as the order is much smaller than 2^512, which makes it suitable
+	// for hashing to scalars. The byte order is the one of SetBytes.
+	SetBytesWide(b [64]byte) Scalar
+
+	// IsCanonical returns true if b is the canonical binary encoding
+	// of a scalar, as produced by MarshalBinary: of the right length
+	// and reduced modulo the group order. UnmarshalBinary may accept
+	// non-canonical encodings, which matters for signature malleability.
+	IsCanonical(b []byte) bool
+
+	// Bytes returns a variable-length representation of the scalar.
+	// The byte order depends on the implementation:
+	// edwards25519 writes big-endian, unlike its SetBytes, and mod.Int,
+	// used by the nist groups, writes in its byte order BO.
+	// Use CanonicalScalarBytes for a group-independent encoding.
 	Bytes() []byte
 
+	// LittleEndianBytes returns the little-endian encoding of the
+	// scalar, padded to MarshalSize bytes, whatever the byte order
+	// of MarshalBinary.
+	LittleEndianBytes() []byte
+
+	// BigEndianBytes returns the big-endian encoding of the scalar,
+	// padded to MarshalSize bytes, whatever the byte order of
+	// MarshalBinary. It is the reverse of LittleEndianBytes.
+	BigEndianBytes() []byte
+
+	// Bit returns the i-th bit of the canonical value of the scalar,
+	// bit 0 being the least significant one, and 0 beyond its
+	// BitLen. It panics if i is negative.
+	Bit(i int) uint
+
+	// BitLen returns the length of the canonical value of the scalar
+	// in bits, 0 for the zero scalar.
+	BitLen() int
+
 	// SetVarTime allows or disallows use of faster variable-time implementations
 	// of operations on this Point. It returns an error if the desired
 	// implementation is not available for the concrete implementation.
@@ -82,12 +132,19 @@ type Point interface {
 	// Equality test for two Points derived from the same Group
 	Equal(s2 Point) bool
 
-	Null() Point // Set to neutral identity element
+	// Set to neutral identity element. Each group has a single, fixed
+	// encoding of the neutral element, however it was computed,
+	// which UnmarshalBinary accepts and decodes back to it:
+	// the encoding of (0, 1) for the Edwards curves, of the point at
+	// infinity as all zeros after the 0x04 prefix for the nist curves,
+	// and of 1 for the residue groups.
+	Null() Point
 
 	// Set to this group's standard base point.
 	Base() Point
 
 	// Pick set to a fresh random or pseudo-random Point.
+	// It never embeds data: use Embed for that.
 	Pick(rand cipher.Stream) Point
 
 	// Set equal to another Point p.
@@ -97,11 +154,12 @@ type Point interface {
 	Clone() Point
 
 	// Maximum number of bytes that can be reliably embedded
-	// in a single group element via Pick().
+	// in a single group element via Embed().
 	EmbedLen() int
 
 	// Embed encodes a limited amount of specified data in the Point.
-	// Implementations only embed the first EmbedLen bytes of the given data.
+	// Implementations only embed the first EmbedLen bytes of the given data;
+	// the caller is responsible for the remainder, data[EmbedLen():].
 	// Currently probabilistic approach requires to include some randomness
 	// given by the cipher.Stream.
 	Embed(data []byte, r cipher.Stream) Point
@@ -121,8 +179,16 @@ type Point interface {
 
 	// Multiply point p by the scalar s.
 	// If p == nil, multiply with the standard base point Base().
+	// As for the other operations, the result is written into the
+	// receiver, which may be p itself: reusing a receiver across
+	// multiplications allocates no point.
 	Mul(s Scalar, p Point) Point
 
+	// Multiply point p by the small integer k, using repeated doubling and
+	// addition. If p == nil, multiply with the standard base point Base().
+	// Not constant time: k is assumed to be public.
+	MulInt(k int64, p Point) Point
+
 	// SetVarTime allows or disallows use of faster variable-time implementations
 	// of operations on this Point. It returns an error if the desired
 	// implementation is not available.
@@ -173,7 +239,21 @@ type Group interface {
 	PointLen() int // Max len of point in bytes
 	Point() Point  // Create new point
 
+	// Generator returns a new point set to the canonical generator of the
+	// group, i.e. the same fixed point as Point().Base().
+	Generator() Point
+
 	PrimeOrder() bool // Returns true if group is prime-order
 
 	NewKey(cipher.Stream) Scalar
 }
+
+// Suite is a cipher suite: a group together with the hash, the cipher and the
+// encoding to use with it, as implemented by the suites of the group
+// packages.
+type Suite interface {
+	Group
+	HashFactory
+	CipherFactory
+	Encoding
+}
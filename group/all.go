@@ -3,7 +3,8 @@
 //
 //  Suite("ed25519")
 //
-// method. Currently, only the "ed25519" suite is available by default. To have
+// method. Currently, only the "ed25519" suite and its variant with 256-bit
+// cipher keys, "ed25519-256", are available by default. To have
 // access to the "curve25519" and all nist/ suites, one needs to build the
 // kyber library with the tag "vartime", such as:
 //
@@ -13,23 +14,100 @@
 package group
 
 import (
-	"strings"
+	"errors"
+	"fmt"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/dedis/kyber/util/random"
 )
 
-var suites = map[string]interface{}{}
+var errorSuiteIncomplete = errors.New("group: suite cannot create scalars, points, hashes, ciphers or keys")
 
 func init() {
-	ed25519 := edwards25519.NewAES128SHA256Ed25519()
-	suites[strings.ToLower(ed25519.String())] = ed25519
+	mustRegister(
+		edwards25519.NewAES128SHA256Ed25519(),
+		edwards25519.NewAES256SHA256Ed25519(),
+	)
+}
+
+// mustRegister registers the suites known to this package.
+func mustRegister(ss ...kyber.Suite) {
+	for _, s := range ss {
+		if err := RegisterSuite(s); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterSuite adds the suite to the registry under its lowercase name,
+// replacing any suite of the same name, which makes it available to this
+// package and to the JSON decoding of key pairs.
+// It first checks that the suite has a name and creates scalars, points,
+// hashes, ciphers and keys, so that an incomplete suite is rejected here
+// rather than failing later.
+func RegisterSuite(s kyber.Suite) (err error) {
+	if s == nil {
+		return errorSuiteIncomplete
+	}
+	// a method missing from a suite embedding nil interfaces panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("group: incomplete suite %T: %v", s, r)
+		}
+	}()
+	if s.String() == "" {
+		return errors.New("group: suite without name")
+	}
+	if s.Scalar() == nil || s.Point() == nil || s.NewKey(random.Stream) == nil {
+		return errorSuiteIncomplete
+	}
+	s.Cipher([]byte("kyber")).Partial(make([]byte, 1), nil, nil)
+	h := s.Hash()
+	if h == nil {
+		return errorSuiteIncomplete
+	}
+	_, _ = h.Write([]byte("kyber"))
+	if len(h.Sum(nil)) == 0 {
+		return errorSuiteIncomplete
+	}
+	registry.Register(s)
+	return nil
 }
 
 // Suite return
 func Suite(name string) interface{} {
-	s, ok := suites[strings.ToLower(name)]
+	s, ok := registry.Lookup(name)
 	if !ok {
 		panic("group has no suite named " + name)
 	}
 	return s
 }
+
+// Lookup returns the suite registered under the given name, or an error if
+// there is none. Unlike Suite, it does not panic on unknown names.
+func Lookup(name string) (interface{}, error) {
+	s, ok := registry.Lookup(name)
+	if !ok {
+		return nil, errors.New("group has no suite named " + name)
+	}
+	return s, nil
+}
+
+// New returns the group of the suite registered under the given name, for
+// code that only builds points and scalars. The returned group doesn't expose
+// the hash, cipher and encoding of the suite: type assertions to, e.g.,
+// kyber.HashFactory fail on it.
+func New(name string) (kyber.Group, error) {
+	s, err := Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return group{s.(kyber.Group)}, nil
+}
+
+// group hides all the methods of a suite but the ones of kyber.Group.
+type group struct {
+	kyber.Group
+}
@@ -0,0 +1,69 @@
+package group
+
+import (
+	"hash"
+	"strings"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range registry.Names() {
+		s := Suite(name)
+		g, err := New(name)
+		require.Nil(t, err, name)
+		require.Equal(t, s.(kyber.Group).String(), g.String(), name)
+		_, ok := g.(kyber.HashFactory)
+		require.False(t, ok, name)
+
+		a := g.Scalar().Pick(random.Stream)
+		b := g.Scalar().Pick(random.Stream)
+		aG := g.Point().Mul(a, nil)
+		bG := g.Point().Mul(b, nil)
+		sum := g.Point().Mul(g.Scalar().Add(a, b), nil)
+		require.True(t, sum.Equal(g.Point().Add(aG, bG)), name)
+		require.True(t, g.Point().Sub(sum, bG).Equal(aG), name)
+		require.True(t, g.Point().Mul(a, bG).Equal(g.Point().Mul(b, aG)), name)
+		require.True(t, g.Scalar().Sub(g.Scalar().Add(a, b), b).Equal(a), name)
+	}
+
+	_, err := New("ed448")
+	require.NotNil(t, err)
+}
+
+// incompleteSuite has a group but no hash, cipher nor encoding.
+type incompleteSuite struct {
+	kyber.Group
+	kyber.HashFactory
+	kyber.CipherFactory
+	kyber.Encoding
+}
+
+// nilHashSuite returns no hash.
+type nilHashSuite struct {
+	kyber.Suite
+}
+
+func (nilHashSuite) Hash() hash.Hash { return nil }
+
+func TestRegisterSuite(t *testing.T) {
+	ed25519 := edwards25519.NewAES128SHA256Ed25519()
+	err := RegisterSuite(incompleteSuite{Group: ed25519})
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "incomplete suite"), err.Error())
+	require.Equal(t, errorSuiteIncomplete, RegisterSuite(nilHashSuite{ed25519}))
+	require.Equal(t, errorSuiteIncomplete, RegisterSuite(nil))
+	_, err = Lookup(ed25519.String())
+	require.Nil(t, err)
+
+	// a complete suite replaces the one of the same name
+	require.Nil(t, RegisterSuite(ed25519))
+	s, err := Lookup(ed25519.String())
+	require.Nil(t, err)
+	require.True(t, s == ed25519)
+}
@@ -8,12 +8,11 @@ import (
 )
 
 func init() {
-	curve25519 := curve25519.NewAES128SHA256Ed25519(false)
-	suites[curve25519.String()] = curve25519
-
-	p256 := nist.NewAES128SHA256P256()
-	suites[p256.String()] = p256
-
-	qr512 := nist.NewAES128SHA256QR512()
-	suites[qr512.String()] = qr512
+	mustRegister(
+		curve25519.NewAES128SHA256Ed25519(false),
+		nist.NewAES128SHA256P256(),
+		nist.NewAES256SHA384P384(),
+		nist.NewAES256SHA512P521(),
+		nist.NewAES128SHA256QR512(),
+	)
 }
@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
 )
@@ -207,6 +208,11 @@ func (P *basicPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// MulInt multiplies point G by the small integer k.
+func (P *basicPoint) MulInt(k int64, G kyber.Point) kyber.Point {
+	return arith.PointMulInt(P, k, G)
+}
+
 // Basic unoptimized reference implementation of Twisted Edwards curves.
 // This reference implementation is mainly intended for testing, debugging,
 // and instructional uses, and not for production use.
@@ -65,6 +65,11 @@ func (c *curve) Scalar() kyber.Scalar {
 	return mod.NewInt64(0, &c.order.V)
 }
 
+// Returns the standard base point of this curve, as defined by its parameters.
+func (c *curve) Generator() kyber.Point {
+	return c.self.Point().Base()
+}
+
 // Returns the size in bytes of an encoded Point on this curve.
 // Uses compressed representation consisting of the y-coordinate
 // and only the sign bit of the x-coordinate.
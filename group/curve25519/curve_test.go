@@ -28,6 +28,18 @@ func TestEd25519(t *testing.T) {
 	test.GroupTest(new(edwards25519.Curve))
 }
 
+func TestContracts(t *testing.T) {
+	for _, g := range []kyber.Group{
+		new(ProjectiveCurve).Init(Param25519(), false),
+		new(ExtendedCurve).Init(Param25519(), false),
+		new(BasicCurve).Init(Param25519(), false),
+		new(ExtendedCurve).Init(Param1174(), true),
+	} {
+		test.ScalarContract(t, g)
+		test.PointContract(t, g)
+	}
+}
+
 // Test the Extended coordinates implementation of each curve.
 
 func Test1174(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/dedis/kyber/util/random"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
 )
@@ -270,6 +271,11 @@ func (P *extPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// MulInt multiplies point G by the small integer k.
+func (P *extPoint) MulInt(k int64, G kyber.Point) kyber.Point {
+	return arith.PointMulInt(P, k, G)
+}
+
 // SetVarTime returns an error if we require constant time operations.
 func (P *extPoint) SetVarTime(varTime bool) error {
 	if !varTime {
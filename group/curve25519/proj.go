@@ -4,11 +4,13 @@ package curve25519
 
 import (
 	"crypto/cipher"
+	"crypto/subtle"
 	"errors"
 	"io"
 	"math/big"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
 	"github.com/dedis/kyber/util/random"
@@ -226,12 +228,83 @@ func (P *projPoint) double() {
 	P.Z.Mul(&F, &J)
 }
 
-// Multiply point p by scalar s using the repeated doubling method.
+// mulWindow is the width in bits of the windows of the scalar in Mul.
+const mulWindow = 4
+
+// Mul multiplies point G by scalar s with a fixed-window method: the
+// multiples 0G, ..., 15G are precomputed, then for every 4-bit window of the
+// scalar, from the top, the result is doubled four times and the multiple
+// selected by the window added. The sequence of operations only depends on
+// the bit length of the group order, not on the scalar: zero windows add the
+// identity, and the multiple is selected by reading the whole table with
+// constant-time copies, so neither branches nor memory accesses depend on the
+// bits of the scalar. The field arithmetic itself, on math/big, is still not
+// constant time, so this curve keeps requiring the vartime build tag.
 func (P *projPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
-	v := s.(*mod.Int).V
 	if G == nil {
 		return P.Base().Mul(s, P)
 	}
+	v := &s.(*mod.Int).V
+	g := G.(*projPoint)
+	c := g.c
+	size := (c.P.BitLen() + 7) / 8
+
+	// table[j] is the encoding of jG
+	var table [1 << mulWindow][]byte
+	T := &projPoint{c: c}
+	T.Set(&c.null)
+	for j := range table {
+		table[j] = T.encodeFixed(size)
+		T.Add(T, g)
+	}
+
+	bits := c.order.V.BitLen()
+	if v.BitLen() > bits {
+		bits = v.BitLen()
+	}
+	E := &projPoint{c: c}
+	E.Set(&c.null)
+	T.Set(&c.null)
+	buf := make([]byte, 3*size)
+	for w := (bits+mulWindow-1)/mulWindow - 1; w >= 0; w-- {
+		for k := 0; k < mulWindow; k++ {
+			T.double()
+		}
+		d := 0
+		for k := mulWindow - 1; k >= 0; k-- {
+			d = d<<1 | int(v.Bit(w*mulWindow+k))
+		}
+		for j := range table {
+			subtle.ConstantTimeCopy(subtle.ConstantTimeEq(int32(j), int32(d)), buf, table[j])
+		}
+		E.X.V.SetBytes(buf[:size])
+		E.Y.V.SetBytes(buf[size : 2*size])
+		E.Z.V.SetBytes(buf[2*size:])
+		T.Add(T, E)
+	}
+	return P.Set(T)
+}
+
+// encodeFixed returns the big-endian encodings of X, Y and Z, each on size
+// bytes, for the table of Mul.
+func (P *projPoint) encodeFixed(size int) []byte {
+	buf := make([]byte, 3*size)
+	for i, coord := range []*mod.Int{&P.X, &P.Y, &P.Z} {
+		b := coord.V.Bytes()
+		copy(buf[(i+1)*size-len(b):], b)
+	}
+	return buf
+}
+
+// mulDoubleAndAdd multiplies point G by scalar s using the repeated doubling
+// method, which branches on the bits of the scalar. It is the former Mul, kept
+// as a reference.
+func (P *projPoint) mulDoubleAndAdd(s kyber.Scalar, G kyber.Point) kyber.Point {
+	v := s.(*mod.Int).V
+	if G == nil {
+		P.Base()
+		return P.mulDoubleAndAdd(s, P)
+	}
 	T := P
 	if G == P { // Must use temporary for in-place multiply
 		T = &projPoint{}
@@ -249,6 +322,11 @@ func (P *projPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// MulInt multiplies point G by the small integer k.
+func (P *projPoint) MulInt(k int64, G kyber.Point) kyber.Point {
+	return arith.PointMulInt(P, k, G)
+}
+
 // SetVarTime returns an error if we request constant-time operations.
 func (P *projPoint) SetVarTime(varTime bool) error {
 	if !varTime {
@@ -0,0 +1,68 @@
+// +build vartime
+
+package curve25519
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+)
+
+func TestProjectiveMul(t *testing.T) {
+	for _, full := range []bool{false, true} {
+		c := new(ProjectiveCurve).Init(Param25519(), full)
+		for i := 0; i < 20; i++ {
+			s := c.Scalar().Pick(random.Stream)
+			if i == 0 {
+				s.Zero()
+			}
+			G := c.Point().Pick(random.Stream)
+			want := c.Point().(*projPoint).mulDoubleAndAdd(s, G)
+			if !c.Point().Mul(s, G).Equal(want) {
+				t.Fatalf("full=%v: Mul differs from double-and-add", full)
+			}
+			if !G.Clone().Mul(s, G).Equal(want) {
+				t.Fatalf("full=%v: in-place Mul differs from double-and-add", full)
+			}
+			want = c.Point().(*projPoint).mulDoubleAndAdd(s, nil)
+			if !c.Point().Mul(s, nil).Equal(want) {
+				t.Fatalf("full=%v: base point Mul differs from double-and-add", full)
+			}
+		}
+	}
+}
+
+func TestProjectiveMulTiming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing measurements skipped in short mode")
+	}
+	c := new(ProjectiveCurve).Init(Param25519(), false)
+	G := c.Point().Pick(random.Stream)
+	P := c.Point()
+	s := c.Scalar()
+	var secrets [][]byte
+	for i := 1; i < 32; i += 2 {
+		low := make([]byte, 32)
+		low[i] = 1
+		high := bytes.Repeat([]byte{0xff}, 32)
+		high[i] = 0xfe
+		high[0] = 0x0f // below the order
+		secrets = append(secrets, low, high)
+	}
+	test.TimingLeakCheck(t, func(secret []byte) {
+		P.Mul(s.SetBytes(secret), G)
+	}, secrets)
+}
+
+func BenchmarkPointMulProjectiveDoubleAndAdd(b *testing.B) {
+	c := new(ProjectiveCurve).Init(Param25519(), false)
+	s := c.Scalar().Pick(random.Stream)
+	G := c.Point().Pick(random.Stream)
+	P := c.Point().(*projPoint)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.mulDoubleAndAdd(s, G)
+	}
+}
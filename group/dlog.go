@@ -0,0 +1,53 @@
+package group
+
+import (
+	"math"
+
+	"github.com/dedis/kyber"
+)
+
+// SolveDiscreteLog returns the integer m in [0, max] such that point == mG,
+// where G is the base point of g, and false if there is none. It is meant to
+// recover small values encrypted in the exponent, such as the tally of an
+// homomorphic ElGamal vote. It uses the baby-step giant-step algorithm, which
+// takes O(sqrt(max)) group operations and stores O(sqrt(max)) encoded points,
+// so max should stay small: 2^40 already needs about 2^20 points in memory.
+func SolveDiscreteLog(g kyber.Group, point kyber.Point, max int64) (int64, bool) {
+	if max < 0 {
+		return 0, false
+	}
+	m := int64(math.Sqrt(float64(max))) + 1 // m*m > max
+
+	// baby steps: jG for j in [0, m)
+	baby := make(map[string]int64, m)
+	P := g.Point().Null()
+	G := g.Point().Base()
+	for j := int64(0); j < m; j++ {
+		buf, err := P.MarshalBinary()
+		if err != nil {
+			return 0, false
+		}
+		if _, ok := baby[string(buf)]; !ok {
+			baby[string(buf)] = j
+		}
+		P.Add(P, G)
+	}
+
+	// giant steps: point - i*mG for i in [0, m)
+	mG := g.Point().Mul(g.Scalar().SetInt64(m), nil)
+	Q := g.Point().Set(point)
+	for i := int64(0); i < m; i++ {
+		buf, err := Q.MarshalBinary()
+		if err != nil {
+			return 0, false
+		}
+		if j, ok := baby[string(buf)]; ok {
+			if x := i*m + j; x <= max {
+				return x, true
+			}
+			return 0, false
+		}
+		Q.Sub(Q, mG)
+	}
+	return 0, false
+}
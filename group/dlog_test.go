@@ -0,0 +1,29 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveDiscreteLog(t *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	for _, c := range []struct{ m, max int64 }{
+		{0, 0}, {0, 10}, {1, 1}, {7, 10}, {10, 10}, {99, 100}, {1000, 5000}, {12345, 20000},
+	} {
+		P := g.Point().Mul(g.Scalar().SetInt64(c.m), nil)
+		m, ok := SolveDiscreteLog(g, P, c.max)
+		require.True(t, ok, "m=%d max=%d", c.m, c.max)
+		require.Equal(t, c.m, m)
+	}
+
+	for _, c := range []struct{ m, max int64 }{{11, 10}, {101, 100}, {5, 0}, {5, -1}} {
+		P := g.Point().Mul(g.Scalar().SetInt64(c.m), nil)
+		_, ok := SolveDiscreteLog(g, P, c.max)
+		require.False(t, ok, "m=%d max=%d", c.m, c.max)
+	}
+	_, ok := SolveDiscreteLog(g, g.Point().Pick(random.Stream), 1000)
+	require.False(t, ok)
+}
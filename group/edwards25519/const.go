@@ -14,6 +14,14 @@ var prime, _ = new(big.Int).SetString("57896044618658097711785492504343953926634
 // prime order of base point = 2^252 + 27742317777372353535851937790883648493
 var primeOrder, _ = new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
 
+// primeOrder in little-endian form, to check that encodings are reduced
+var primeOrderBytes = [32]byte{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
+
 // `l_minus_2` is the order of base point minus two, i.e. 2^252 +
 // 27742317777372353535851937790883648493 - 2, in little-endian form
 // This is needed to compute constant time modular inversion of scalars.
@@ -35,6 +35,12 @@ func (c *Curve) Scalar() kyber.Scalar {
 	return &scalar{}
 }
 
+// Generator returns the canonical base point of the Ed25519 curve,
+// which generates its prime-order subgroup.
+func (c *Curve) Generator() kyber.Point {
+	return c.Point().Base()
+}
+
 // PointLen returns 32, the size in bytes of an encoded Point on the Ed25519 curve.
 func (c *Curve) PointLen() int {
 	return 32
@@ -1,8 +1,11 @@
 package edwards25519
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
+	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/util/test"
 )
 
@@ -11,10 +14,89 @@ var groupBench = test.NewGroupBench(testSuite)
 
 func TestSuite(t *testing.T) { test.SuiteTest(testSuite) }
 
+func TestContracts(t *testing.T) {
+	test.ScalarContract(t, testSuite)
+	test.PointContract(t, testSuite)
+}
+
+func TestPickDeterminism(t *testing.T) { test.PickDeterminism(t, testSuite) }
+
+// timingSecrets returns 32-byte secrets of low and high Hamming weights, all
+// below the prime order.
+func timingSecrets() [][]byte {
+	var secrets [][]byte
+	for i := 0; i < 16; i++ {
+		low := make([]byte, 32)
+		low[i] = 1
+		high := bytes.Repeat([]byte{0xff}, 32)
+		high[i] = 0xfe
+		high[31] = 0x0f
+		secrets = append(secrets, low, high)
+	}
+	return secrets
+}
+
+func TestTimingLeak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing measurements skipped in short mode")
+	}
+	P := testSuite.Point().Pick(random.Stream)
+	Q := testSuite.Point()
+	a := testSuite.Scalar().Pick(random.Stream)
+	s := testSuite.Scalar()
+	r := testSuite.Scalar()
+	var wide [64]byte
+	setSecret := func(secret []byte) {
+		copy(wide[:], secret)
+		s.SetBytesWide(wide)
+	}
+
+	test.TimingLeakCheck(t, func(secret []byte) {
+		setSecret(secret)
+		Q.Mul(s, P)
+	}, timingSecrets())
+	test.TimingLeakCheck(t, func(secret []byte) {
+		setSecret(secret)
+		Q.Mul(s, nil)
+	}, timingSecrets())
+	test.TimingLeakCheck(t, func(secret []byte) {
+		setSecret(secret)
+		r.Mul(s, a)
+	}, timingSecrets())
+}
+
+func TestSuite256(t *testing.T) {
+	suite := NewAES256SHA256Ed25519()
+	test.SuiteTest(suite)
+
+	key := random.Bytes(32, random.Stream)
+	msg := []byte("sealed with a 256-bit key")
+	c := suite.Cipher(key)
+	if c.KeySize() != 32 {
+		t.Fatalf("cipher key size is %d, not 32", c.KeySize())
+	}
+	ctx := c.Seal(nil, msg)
+	dec, err := suite.Cipher(key).Open(nil, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(msg, dec) {
+		t.Fatal("decrypted message differs")
+	}
+	// the 128-bit suite can't open it
+	if _, err := testSuite.Cipher(key).Open(nil, ctx); err == nil {
+		t.Fatal("message opened with a different cipher")
+	}
+	if suite.String() == testSuite.String() {
+		t.Fatal("both suites have the same name")
+	}
+}
+
 func BenchmarkScalarAdd(b *testing.B)    { groupBench.ScalarAdd(b.N) }
 func BenchmarkScalarSub(b *testing.B)    { groupBench.ScalarSub(b.N) }
 func BenchmarkScalarNeg(b *testing.B)    { groupBench.ScalarNeg(b.N) }
 func BenchmarkScalarMul(b *testing.B)    { groupBench.ScalarMul(b.N) }
+func BenchmarkScalarMulAdd(b *testing.B) { groupBench.ScalarMulAdd(b.N) }
 func BenchmarkScalarDiv(b *testing.B)    { groupBench.ScalarDiv(b.N) }
 func BenchmarkScalarInv(b *testing.B)    { groupBench.ScalarInv(b.N) }
 func BenchmarkScalarPick(b *testing.B)   { groupBench.ScalarPick(b.N) }
@@ -24,8 +106,51 @@ func BenchmarkScalarDecode(b *testing.B) { groupBench.ScalarDecode(b.N) }
 func BenchmarkPointAdd(b *testing.B)     { groupBench.PointAdd(b.N) }
 func BenchmarkPointSub(b *testing.B)     { groupBench.PointSub(b.N) }
 func BenchmarkPointNeg(b *testing.B)     { groupBench.PointNeg(b.N) }
-func BenchmarkPointMul(b *testing.B)     { groupBench.PointMul(b.N) }
+func BenchmarkPointMul(b *testing.B)     { b.ReportAllocs(); groupBench.PointMul(b.N) }
+func BenchmarkPointMulNew(b *testing.B)  { b.ReportAllocs(); groupBench.PointMulNew(b.N) }
 func BenchmarkPointBaseMul(b *testing.B) { groupBench.PointBaseMul(b.N) }
 func BenchmarkPointPick(b *testing.B)    { groupBench.PointPick(b.N) }
 func BenchmarkPointEncode(b *testing.B)  { groupBench.PointEncode(b.N) }
 func BenchmarkPointDecode(b *testing.B)  { groupBench.PointDecode(b.N) }
+
+func TestGeneratorOrder(t *testing.T) {
+	G := testSuite.Generator()
+	if !G.Equal(testSuite.Point().Base()) {
+		t.Fatal("generator is not the base point")
+	}
+	if G.Equal(testSuite.Point().Null()) {
+		t.Fatal("generator is the neutral element")
+	}
+	if !testSuite.Point().Mul(primeOrderScalar, G).Equal(testSuite.Point().Null()) {
+		t.Fatal("generator does not have the prime order")
+	}
+}
+
+// benchPVSS runs op on a PVSSBench of each committee size, with a threshold of
+// two thirds of the trustees plus one.
+func benchPVSS(b *testing.B, op func(pb *test.PVSSBench, iters int)) {
+	for _, n := range []int{10, 100} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			pb := test.NewPVSSBench(testSuite, 2*n/3+1, n)
+			b.ResetTimer()
+			op(pb, b.N)
+		})
+	}
+}
+
+func BenchmarkPVSSEncShares(b *testing.B)   { benchPVSS(b, (*test.PVSSBench).EncShares) }
+func BenchmarkPVSSVerifyBatch(b *testing.B) { benchPVSS(b, (*test.PVSSBench).VerifyBatch) }
+func BenchmarkPVSSDecBatch(b *testing.B)    { benchPVSS(b, (*test.PVSSBench).DecBatch) }
+func BenchmarkPVSSRecover(b *testing.B)     { benchPVSS(b, (*test.PVSSBench).Recover) }
+
+func TestPointMulAllocs(t *testing.T) {
+	s := testSuite.Scalar().Pick(random.Stream)
+	A := testSuite.Point().Pick(random.Stream)
+	P := testSuite.Point()
+	if n := testing.AllocsPerRun(10, func() { P.Mul(s, A) }); n != 0 {
+		t.Errorf("Mul into a reused receiver makes %v allocations", n)
+	}
+	if n := testing.AllocsPerRun(10, func() { P.Mul(s, nil) }); n != 0 {
+		t.Errorf("Mul of the base point into a reused receiver makes %v allocations", n)
+	}
+}
@@ -311,6 +311,15 @@ func chi(out, z *fieldElement) {
 // key.
 // See Elligator paper section 5.2.
 func repToCurve25519(publicKey, representative *[32]byte) {
+	var u fieldElement
+	repToMontgomery(&u, representative)
+	feToBytes(publicKey, &u) // Curve25519 pubkey
+}
+
+// repToMontgomery sets u to the Montgomery x-coordinate represented by the
+// representative. It returns 1 if the representative was mapped with e = -1
+// and 0 otherwise.
+func repToMontgomery(u *fieldElement, representative *[32]byte) int32 {
 	var rr2, v, e fieldElement
 	feFromBytes(&rr2, representative[:])
 
@@ -340,7 +349,6 @@ func repToCurve25519(publicKey, representative *[32]byte) {
 	feCMove(&v, &negV, eIsMinus1) // v <- ev
 	feZero(&v2)
 	feCMove(&v2, &paramA, eIsMinus1) // v2 <- (1-e)A/2 (= 0 or A)
-	feSub(&v, &v, &v2)
-
-	feToBytes(publicKey, &v) // Curve25519 pubkey
+	feSub(u, &v, &v2)
+	return eIsMinus1
 }
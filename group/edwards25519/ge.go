@@ -396,14 +396,14 @@ func negative(b int32) int32 {
 	return (b >> 31) & 1
 }
 
-func selectPreComputed(t *preComputedGroupElement, pos int32, b int32) {
+func selectPreComputed(t *preComputedGroupElement, table *[32][8]preComputedGroupElement, pos int32, b int32) {
 	var minusT preComputedGroupElement
 	bNegative := negative(b)
 	bAbs := b - (((-bNegative) & b) << 1)
 
 	t.Zero()
 	for i := int32(0); i < 8; i++ {
-		t.CMove(&base[pos][i], equal(bAbs, i+1))
+		t.CMove(&table[pos][i], equal(bAbs, i+1))
 	}
 	minusT.Neg(t)
 	t.CMove(&minusT, bNegative)
@@ -416,6 +416,15 @@ func selectPreComputed(t *preComputedGroupElement, pos int32, b int32) {
 // Preconditions:
 //   a[31] <= 127
 func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
+	geScalarMultTable(h, a, &base)
+}
+
+// geScalarMultTable computes h = a*A, where table holds the multiples of A
+// computed by geTable.
+//
+// Preconditions:
+//   a[31] <= 127
+func geScalarMultTable(h *extendedGroupElement, a *[32]byte, table *[32][8]preComputedGroupElement) {
 	var e [64]int8
 
 	for i, v := range a {
@@ -438,7 +447,7 @@ func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
 	var t preComputedGroupElement
 	var r completedGroupElement
 	for i := int32(1); i < 64; i += 2 {
-		selectPreComputed(&t, i/2, int32(e[i]))
+		selectPreComputed(&t, table, i/2, int32(e[i]))
 		r.MixedAdd(h, &t)
 		r.ToExtended(h)
 	}
@@ -455,12 +464,49 @@ func geScalarMultBase(h *extendedGroupElement, a *[32]byte) {
 	r.ToExtended(h)
 
 	for i := int32(0); i < 64; i += 2 {
-		selectPreComputed(&t, i/2, int32(e[i]))
+		selectPreComputed(&t, table, i/2, int32(e[i]))
 		r.MixedAdd(h, &t)
 		r.ToExtended(h)
 	}
 }
 
+// geTable sets table[i][j] to (j+1)*256^i*A, the layout of base, for use
+// with geScalarMultTable.
+func geTable(table *[32][8]preComputedGroupElement, A *extendedGroupElement) {
+	var Ai, u extendedGroupElement
+	var c cachedGroupElement
+	var t completedGroupElement
+	var r projectiveGroupElement
+	var x, y, zInv fieldElement
+
+	Ai = *A
+	for i := range table {
+		u = Ai
+		Ai.ToCached(&c)
+		for j := range table[i] {
+			if j > 0 {
+				t.Add(&u, &c)
+				t.ToExtended(&u)
+			}
+			feInvert(&zInv, &u.Z)
+			feMul(&x, &u.X, &zInv)
+			feMul(&y, &u.Y, &zInv)
+			feAdd(&table[i][j].yPlusX, &y, &x)
+			feSub(&table[i][j].yMinusX, &y, &x)
+			feMul(&table[i][j].xy2d, &x, &y)
+			feMul(&table[i][j].xy2d, &table[i][j].xy2d, &d2)
+		}
+
+		// Ai <<= 8
+		Ai.ToProjective(&r)
+		for k := 0; k < 8; k++ {
+			r.Double(&t)
+			t.ToProjective(&r)
+		}
+		t.ToExtended(&Ai)
+	}
+}
+
 func selectCached(c *cachedGroupElement, Ai *[8]cachedGroupElement, b int32) {
 	bNegative := negative(b)
 	bAbs := b - (((-bNegative) & b) << 1)
@@ -0,0 +1,68 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// geScalarMultDoubleAdd computes h = a*A with the plain double-and-add
+// method. It serves as a baseline for the windowed implementations.
+func geScalarMultDoubleAdd(h *extendedGroupElement, a *[32]byte,
+	A *extendedGroupElement) {
+
+	var t completedGroupElement
+	var c cachedGroupElement
+	A.ToCached(&c)
+	h.Zero()
+	for i := 255; i >= 0; i-- {
+		h.Double(&t)
+		t.ToExtended(h)
+		if (a[i/8]>>uint(i&7))&1 == 1 {
+			t.Add(h, &c)
+			t.ToExtended(h)
+		}
+	}
+}
+
+func TestScalarMultImplementations(t *testing.T) {
+	var h1, h2, h3 extendedGroupElement
+	var b1, b2, b3 [32]byte
+	for i := 0; i < 500; i++ {
+		a := &testSuite.Scalar().Pick(random.Stream).(*scalar).v
+		A := &testSuite.Point().Pick(random.Stream).(*point).ge
+
+		geScalarMultDoubleAdd(&h1, a, A)
+		geScalarMult(&h2, a, A)
+		geScalarMultVartime(&h3, a, A)
+
+		h1.ToBytes(&b1)
+		h2.ToBytes(&b2)
+		h3.ToBytes(&b3)
+		if b1 != b2 {
+			t.Fatal("windowed scalar multiplication differs from double-and-add")
+		}
+		if b1 != b3 {
+			t.Fatal("wNAF scalar multiplication differs from double-and-add")
+		}
+	}
+}
+
+func benchScalarMult(b *testing.B, mul func(*extendedGroupElement, *[32]byte, *extendedGroupElement)) {
+	var h extendedGroupElement
+	a := &testSuite.Scalar().Pick(random.Stream).(*scalar).v
+	A := &testSuite.Point().Pick(random.Stream).(*point).ge
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mul(&h, a, A)
+	}
+}
+
+// Baseline: one addition per set bit of the scalar
+func BenchmarkScalarMultDoubleAdd(b *testing.B) { benchScalarMult(b, geScalarMultDoubleAdd) }
+
+// Constant-time signed 4-bit fixed window, used by default by Point.Mul
+func BenchmarkScalarMultWindow(b *testing.B) { benchScalarMult(b, geScalarMult) }
+
+// Variable-time sliding window NAF, used by Point.Mul after SetVarTime(true)
+func BenchmarkScalarMultWNAF(b *testing.B) { benchScalarMult(b, geScalarMultVartime) }
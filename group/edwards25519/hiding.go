@@ -0,0 +1,141 @@
+package edwards25519
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// elligatorAttempts bounds the number of random torsion components tried by
+// MarshalElligator before it gives up on a point.
+const elligatorAttempts = 32
+
+var errorElligatorLength = errors.New("invalid length for an Elligator representative")
+
+// torsion is a point of order 8, whose multiples are the low-order points of
+// the curve.
+var torsion point
+
+// inv8 is the inverse of 8 modulo the prime order.
+var inv8 scalar
+
+func init() {
+	b, _ := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	if err := torsion.UnmarshalBinary(b); err != nil {
+		panic(err)
+	}
+	inv8.Inv(new(scalar).SetInt64(8))
+}
+
+// HideLen returns the length of the Elligator representatives of the points.
+func (P *point) HideLen() int {
+	return 32
+}
+
+// HideEncode returns a representative of the point that is indistinguishable
+// from 32 uniformly random bytes, using the Elligator 2 map to the equivalent
+// Montgomery curve. A random low-order point is first added to P, as the
+// representatives of the points of the prime-order subgroup alone could be
+// told apart from random strings by decoding them, and the two unused high
+// bits of the representative are filled with random bits. About half of the
+// attempts fail, in which case HideEncode returns nil: it can be called again,
+// as each attempt picks another low-order point.
+func (P *point) HideEncode(rand cipher.Stream) []byte {
+	var tweak [1]byte
+	rand.XORKeyStream(tweak[:], tweak[:])
+
+	var Q point
+	Q.Mul(new(scalar).SetInt64(int64(tweak[0]&7)), &torsion)
+	Q.Add(P, &Q)
+
+	var u, rep [32]byte
+	if !pointToRep(&u, &rep, &Q.ge) {
+		return nil
+	}
+	// choose the representative in [0, (p-1)/2], which leaves the two high
+	// bits free
+	var r, negR fieldElement
+	feFromBytes(&r, rep[:])
+	feNeg(&negR, &r)
+	feCMove(&r, &negR, 1-feBytesLE(&rep, &halfQMinus1Bytes))
+	feToBytes(&rep, &r)
+
+	// the map excludes a few exceptional points
+	var R point
+	R.HideDecode(rep[:])
+	if !R.Equal(P) {
+		return nil
+	}
+	rep[31] |= tweak[0] & 0xc0
+	return rep[:]
+}
+
+// HideDecode sets P to the point represented by buf, which must be
+// HideLen bytes long. Every string maps to a point of the prime-order
+// subgroup, the low-order component added by HideEncode being removed.
+func (P *point) HideDecode(buf []byte) {
+	if len(buf) != P.HideLen() {
+		panic("edwards25519: wrong size buffer for HideDecode")
+	}
+	var rep [32]byte
+	copy(rep[:], buf)
+	rep[31] &= 0x3f
+
+	var u fieldElement
+	eIsMinus1 := repToMontgomery(&u, &rep)
+
+	// y = (u-1)/(u+1)
+	var one, num, den, y fieldElement
+	feOne(&one)
+	feSub(&num, &u, &one)
+	feAdd(&den, &u, &one)
+	feInvert(&den, &den)
+	feMul(&y, &num, &den)
+	var yBytes [32]byte
+	feToBytes(&yBytes, &y)
+	if !P.ge.FromBytes(yBytes[:]) {
+		P.ge.Zero()
+		return
+	}
+
+	// HideEncode takes the representative of the branch with e = -1 exactly
+	// when v = sqrt(-A)u/x is at most (p-1)/2, which gives the sign of x.
+	var v fieldElement
+	feInvert(&v, &P.ge.X)
+	feMul(&v, &v, &u)
+	feMul(&v, &v, &sqrtMinusA)
+	var vBytes [32]byte
+	feToBytes(&vBytes, &v)
+	if feBytesLE(&vBytes, &halfQMinus1Bytes) != eIsMinus1 {
+		P.Neg(P)
+	}
+
+	// remove the low-order component: P = 8^-1 (8P)
+	P.MulInt(8, P)
+	P.Mul(&inv8, P)
+}
+
+// MarshalElligator returns a representative of the point indistinguishable
+// from 32 uniformly random bytes, see HideEncode, trying several random
+// low-order components. It returns false if none of them worked: the point
+// has to be re-picked, which happens for a few points in a thousand.
+func (P *point) MarshalElligator() ([]byte, bool) {
+	for i := 0; i < elligatorAttempts; i++ {
+		if rep := P.HideEncode(random.Stream); rep != nil {
+			return rep, true
+		}
+	}
+	return nil, false
+}
+
+// UnmarshalElligator sets P to the point represented by b, as returned by
+// MarshalElligator. Every 32-byte string represents a point.
+func (P *point) UnmarshalElligator(b []byte) error {
+	if len(b) != P.HideLen() {
+		return errorElligatorLength
+	}
+	P.HideDecode(b)
+	return nil
+}
@@ -0,0 +1,77 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// The points can be hidden, e.g. with key.NewHidingKeyPair.
+var _ kyber.Hiding = new(point)
+
+func TestTorsion(t *testing.T) {
+	var Q point
+	require.False(t, Q.MulInt(4, &torsion).Equal(new(point).Null()))
+	require.True(t, Q.MulInt(8, &torsion).Equal(new(point).Null()))
+	require.True(t, Q.Mul(&inv8, Q.MulInt(8, testSuite.Point().Base())).Equal(testSuite.Point().Base()))
+}
+
+func TestElligatorRoundTrip(t *testing.T) {
+	representable := 0
+	for i := 0; i < 200; i++ {
+		P := testSuite.Point().Pick(random.Stream).(*point)
+		rep, ok := P.MarshalElligator()
+		if !ok {
+			continue
+		}
+		representable++
+		require.Equal(t, 32, len(rep))
+		var Q point
+		require.Nil(t, Q.UnmarshalElligator(rep))
+		require.True(t, Q.Equal(P))
+	}
+	require.True(t, representable > 190, "only %d representable points", representable)
+
+	var Q point
+	require.Equal(t, errorElligatorLength, Q.UnmarshalElligator(make([]byte, 31)))
+}
+
+func TestHiding(t *testing.T) {
+	// every string decodes to a point of the prime-order subgroup, which
+	// encodes back to a representative of the same point
+	buf := make([]byte, 32)
+	var P, Q point
+	for i := 0; i < 100; i++ {
+		random.Stream.XORKeyStream(buf, buf)
+		P.HideDecode(buf)
+		var R point
+		require.True(t, R.Mul(&inv8, R.MulInt(8, &P)).Equal(&P))
+		rep, ok := P.MarshalElligator()
+		require.True(t, ok)
+		Q.HideDecode(rep)
+		require.True(t, Q.Equal(&P))
+	}
+}
+
+func TestElligatorUniformity(t *testing.T) {
+	// each bit of the representatives, including the two high bits that
+	// the map leaves unused, is set about half of the time
+	n := 2000
+	var counts [256]int
+	for i := 0; i < n; {
+		rep, ok := testSuite.Point().Pick(random.Stream).(*point).MarshalElligator()
+		if !ok {
+			continue
+		}
+		for j := range counts {
+			counts[j] += int(rep[j/8]>>uint(j%8)) & 1
+		}
+		i++
+	}
+	for j, c := range counts {
+		// 6 standard deviations
+		require.True(t, c > n/2-135 && c < n/2+135, "bit %d set %d times out of %d", j, c, n)
+	}
+}
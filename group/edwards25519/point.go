@@ -21,6 +21,7 @@ import (
 	"io"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 )
 
@@ -223,6 +224,11 @@ func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
 	return P
 }
 
+// MulInt multiplies point A by the small integer k.
+func (P *point) MulInt(k int64, A kyber.Point) kyber.Point {
+	return arith.PointMulInt(P, k, A)
+}
+
 // SetVarTime allows for optimized, non-constant time implementation.
 func (P *point) SetVarTime(varTime bool) error {
 	P.varTime = varTime
@@ -0,0 +1,32 @@
+package edwards25519
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// PrecomputedPoint holds a table of multiples of a point, computed once by
+// PrecomputePoint, with which the point is multiplied by a scalar about as fast
+// as the standard base point. This pays off for points multiplied by many
+// scalars, such as the public keys of the trustees in a PVSS verification.
+//
+// The table holds 256 points and takes 30 kB, 120 bytes per point; computing
+// it costs about as much as 20 ordinary multiplications. Mul runs in constant
+// time, and a PrecomputedPoint can be used from several goroutines.
+type PrecomputedPoint struct {
+	table [32][8]preComputedGroupElement
+}
+
+// PrecomputePoint computes the table of multiples of p, which must be a point
+// of this package.
+func PrecomputePoint(p kyber.Point) *PrecomputedPoint {
+	pp := new(PrecomputedPoint)
+	geTable(&pp.table, &p.(*point).ge)
+	return pp
+}
+
+// Mul returns s times the precomputed point, as a new point.
+func (pp *PrecomputedPoint) Mul(s kyber.Scalar) kyber.Point {
+	P := new(point)
+	geScalarMultTable(&P.ge, &s.(*scalar).v, &pp.table)
+	return P
+}
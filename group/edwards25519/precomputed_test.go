@@ -0,0 +1,69 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeTableBase(t *testing.T) {
+	var table [32][8]preComputedGroupElement
+	geTable(&table, &testSuite.Point().Base().(*point).ge)
+	var b1, b2 [32]byte
+	for i := range table {
+		for j := range table[i] {
+			for _, f := range [][2]*fieldElement{
+				{&table[i][j].yPlusX, &base[i][j].yPlusX},
+				{&table[i][j].yMinusX, &base[i][j].yMinusX},
+				{&table[i][j].xy2d, &base[i][j].xy2d},
+			} {
+				feToBytes(&b1, f[0])
+				feToBytes(&b2, f[1])
+				require.Equal(t, b2, b1, "table entry [%d][%d]", i, j)
+			}
+		}
+	}
+}
+
+func TestPrecomputedPoint(t *testing.T) {
+	X := testSuite.Point().Pick(random.Stream)
+	pp := PrecomputePoint(X)
+	for i := 0; i < 20; i++ {
+		s := testSuite.Scalar().Pick(random.Stream)
+		require.True(t, pp.Mul(s).Equal(testSuite.Point().Mul(s, X)))
+	}
+	require.True(t, pp.Mul(testSuite.Scalar().Zero()).Equal(testSuite.Point().Null()))
+	require.True(t, pp.Mul(testSuite.Scalar().One()).Equal(X))
+	minusOne := testSuite.Scalar().Neg(testSuite.Scalar().One())
+	require.True(t, pp.Mul(minusOne).Equal(testSuite.Point().Neg(X)))
+}
+
+func BenchmarkPrecomputePoint(b *testing.B) {
+	X := testSuite.Point().Pick(random.Stream)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		PrecomputePoint(X)
+	}
+}
+
+func BenchmarkPrecomputedPointMul(b *testing.B) {
+	X := testSuite.Point().Pick(random.Stream)
+	s := testSuite.Scalar().Pick(random.Stream)
+	pp := PrecomputePoint(X)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pp.Mul(s)
+	}
+}
+
+// BenchmarkPointMulSameBase is the baseline of BenchmarkPrecomputedPointMul.
+func BenchmarkPointMulSameBase(b *testing.B) {
+	X := testSuite.Point().Pick(random.Stream)
+	s := testSuite.Scalar().Pick(random.Stream)
+	P := testSuite.Point()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.Mul(s, X)
+	}
+}
@@ -73,6 +73,19 @@ func (s *scalar) One() kyber.Scalar {
 	return s
 }
 
+// IsZero returns true if the scalar is 0, in constant time
+func (s *scalar) IsZero() bool {
+	return subtle.ConstantTimeCompare(s.v[:], scZero[:]) == 1
+}
+
+// IsOne returns true if the scalar is 1, in constant time
+func (s *scalar) IsOne() bool {
+	return subtle.ConstantTimeCompare(s.v[:], scOne[:]) == 1
+}
+
+var scZero = [32]byte{0}
+var scOne = [32]byte{1}
+
 // Set to the modular sum of scalars a and b
 func (s *scalar) Add(a, b kyber.Scalar) kyber.Scalar {
 	scAdd(&s.v, &a.(*scalar).v, &b.(*scalar).v)
@@ -99,7 +112,14 @@ func (s *scalar) Mul(a, b kyber.Scalar) kyber.Scalar {
 	return s
 }
 
-// Set to the modular division of scalar a by scalar b
+// Set to the modular sum of the product a*b and c
+func (s *scalar) MulAdd(a, b, c kyber.Scalar) kyber.Scalar {
+	scMulAdd(&s.v, &a.(*scalar).v, &b.(*scalar).v, &c.(*scalar).v)
+	return s
+}
+
+// Set to the modular division of scalar a by scalar b.
+// It panics if b is zero.
 func (s *scalar) Div(a, b kyber.Scalar) kyber.Scalar {
 	var i scalar
 	i.Inv(b)
@@ -107,11 +127,15 @@ func (s *scalar) Div(a, b kyber.Scalar) kyber.Scalar {
 	return s
 }
 
-// Set to the modular inverse of scalar a
+// Set to the modular inverse of scalar a.
+// It panics if a is zero, which has no inverse.
 func (s *scalar) Inv(a kyber.Scalar) kyber.Scalar {
 	var res scalar
 	res.One()
 	ac := a.(*scalar)
+	if subtle.ConstantTimeAllEq(ac.v[:], 0) == 1 {
+		panic("ed25519: inverse of zero scalar")
+	}
 	// Modular inversion in a multiplicative group is a^(phi(m)-1) = a^-1 mod m
 	// Since m is prime, phi(m) = m - 1 => a^(m-2) = a^-1 mod m.
 	// The inverse is computed  using the exponentation-and-square algorithm.
@@ -140,6 +164,13 @@ func (s *scalar) SetBytes(b []byte) kyber.Scalar {
 	return s.setInt(mod.NewIntBytes(b, primeOrder, mod.LittleEndian))
 }
 
+// SetBytesWide reduces the 64-byte little-endian integer b modulo the prime
+// order, in constant time.
+func (s *scalar) SetBytesWide(b [64]byte) kyber.Scalar {
+	scReduce(&s.v, &b)
+	return s
+}
+
 // SetVarTime returns an error if we request constant-time operations.
 func (s *scalar) SetVarTime(varTime bool) error {
 	if varTime {
@@ -148,6 +179,41 @@ func (s *scalar) SetVarTime(varTime bool) error {
 	return nil
 }
 
+// LittleEndianBytes returns the 32-byte little-endian encoding of the
+// scalar, the same as MarshalBinary.
+func (s *scalar) LittleEndianBytes() []byte {
+	buf := make([]byte, 32)
+	copy(buf, s.v[:])
+	return buf
+}
+
+// BigEndianBytes returns the 32-byte big-endian encoding of the scalar.
+func (s *scalar) BigEndianBytes() []byte {
+	return bytes.Reverse(nil, s.v[:])
+}
+
+// Bit returns the i-th bit of the scalar, bit 0 being the least significant
+// one. It panics if i is negative.
+func (s *scalar) Bit(i int) uint {
+	if i < 0 {
+		panic("edwards25519: negative bit index")
+	}
+	if i >= 256 {
+		return 0
+	}
+	return uint(s.v[i/8]>>uint(i%8)) & 1
+}
+
+// BitLen returns the length of the scalar in bits, 0 for the zero scalar.
+func (s *scalar) BitLen() int {
+	for i := 255; i >= 0; i-- {
+		if s.Bit(i) == 1 {
+			return i + 1
+		}
+	}
+	return 0
+}
+
 // Bytes returns a big-Endian representation of the scalar
 func (s *scalar) Bytes() []byte {
 	var buf = s.v
@@ -185,6 +251,20 @@ func (s *scalar) UnmarshalBinary(buf []byte) error {
 	return nil
 }
 
+// IsCanonical returns true if b is a 32-byte little-endian encoding of a value
+// lower than the prime order.
+func (s *scalar) IsCanonical(b []byte) bool {
+	if len(b) != 32 {
+		return false
+	}
+	for i := 31; i >= 0; i-- {
+		if b[i] != primeOrderBytes[i] {
+			return b[i] < primeOrderBytes[i]
+		}
+	}
+	return false
+}
+
 // MarshalTo writes the binary representation of this scalar to the given
 // writer.
 func (s *scalar) MarshalTo(w io.Writer) (int, error) {
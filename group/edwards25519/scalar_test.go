@@ -1,6 +1,7 @@
 package edwards25519
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dedis/kyber/util/random"
@@ -98,6 +99,101 @@ func TestSimpleCTScalar(t *testing.T) {
 	testSimple(t, newSimpleCTScalar)
 }
 
+func TestScalarDivByZero(t *testing.T) {
+	expectPanic := func(name string, f func()) {
+		defer func() {
+			if recover() == nil {
+				t.Error(name + " with a zero scalar should panic")
+			}
+		}()
+		f()
+	}
+	a := testSuite.Scalar().Pick(random.Stream)
+	expectPanic("Inv", func() { testSuite.Scalar().Inv(testSuite.Scalar().Zero()) })
+	expectPanic("Div", func() { testSuite.Scalar().Div(a, testSuite.Scalar().Zero()) })
+}
+
+func TestScalarIsCanonical(t *testing.T) {
+	s := testSuite.Scalar()
+	l := primeOrderBytes
+	if s.IsCanonical(l[:]) {
+		t.Error("the encoding of the order should not be canonical")
+	}
+	l[0]--
+	if !s.IsCanonical(l[:]) {
+		t.Error("the encoding of the order minus one should be canonical")
+	}
+	l[0]++
+	l[31]++
+	if s.IsCanonical(l[:]) {
+		t.Error("an encoding above the order should not be canonical")
+	}
+	// UnmarshalBinary doesn't check it
+	if err := s.UnmarshalBinary(primeOrderBytes[:]); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScalarSetBytesWide(t *testing.T) {
+	var b [64]byte
+	for i := 0; i < 100; i++ {
+		copy(b[:], random.Bytes(64, random.Stream))
+		if i == 0 {
+			for j := range b {
+				b[j] = 0xff
+			}
+		}
+		// reference: the little-endian integer reduced with math/big
+		be := make([]byte, 64)
+		for j := range b {
+			be[63-j] = b[j]
+		}
+		ref := new(big.Int).SetBytes(be)
+		ref.Mod(ref, primeOrder)
+
+		s := testSuite.Scalar().SetBytesWide(b).(*scalar)
+		if s.toInt().V.Cmp(ref) != 0 {
+			t.Fatalf("wide reduction of %x is %v, expected %v", b, s, ref)
+		}
+	}
+}
+
+func TestScalarBits(t *testing.T) {
+	s := testSuite.Scalar().SetInt64(0xb) // 1011
+	for i, bit := range []uint{1, 1, 0, 1, 0} {
+		if s.Bit(i) != bit {
+			t.Errorf("bit %d of 0xb is %d", i, s.Bit(i))
+		}
+	}
+	if s.BitLen() != 4 {
+		t.Errorf("0xb is %d bits long", s.BitLen())
+	}
+	if s.Bit(300) != 0 {
+		t.Error("bit beyond the encoding is set")
+	}
+	if testSuite.Scalar().Zero().BitLen() != 0 {
+		t.Error("zero has a non-zero length")
+	}
+
+	// the order minus one is 2^252 + 27742317777372353535851937790883648492
+	minusOne := testSuite.Scalar().Neg(testSuite.Scalar().One())
+	if minusOne.BitLen() != 253 || minusOne.Bit(252) != 1 || minusOne.Bit(251) != 0 {
+		t.Errorf("order minus one is %d bits long", minusOne.BitLen())
+	}
+
+	// the bits match the canonical value
+	r := testSuite.Scalar().Pick(random.Stream)
+	ref := r.(*scalar).toInt().V
+	if r.BitLen() != ref.BitLen() {
+		t.Errorf("%v is %d bits long, expected %d", r, r.BitLen(), ref.BitLen())
+	}
+	for i := 0; i < 256; i++ {
+		if r.Bit(i) != ref.Bit(i) {
+			t.Fatalf("bit %d of %v is %d", i, r, r.Bit(i))
+		}
+	}
+}
+
 func testSimple(t *testing.T, new func() kyber.Scalar) {
 	s1 := new()
 	s2 := new()
@@ -20,6 +20,7 @@ import (
 // and CipherFactory.
 type SuiteEd25519 struct {
 	Curve
+	keySize int // cipher key size in bytes, 16 if zero
 }
 
 // Hash return a newly instanciated sha256 hash function
@@ -27,11 +28,24 @@ func (s *SuiteEd25519) Hash() hash.Hash {
 	return sha256.New()
 }
 
-// Cipher returns the SHA3/SHAKE128 Sponge Cipher
+// Cipher returns the SHA3/SHAKE128 Sponge Cipher, or the SHA3/SHAKE256 one if
+// the suite uses 256-bit cipher keys.
 func (s *SuiteEd25519) Cipher(key []byte, options ...interface{}) kyber.Cipher {
+	if s.keySize == 32 {
+		return sha3.NewShakeCipher256(key, options...)
+	}
 	return sha3.NewShakeCipher128(key, options...)
 }
 
+// String returns the name of the suite: "Ed25519", with a "-256" suffix if the
+// suite uses 256-bit cipher keys, so both variants can be registered.
+func (s *SuiteEd25519) String() string {
+	if s.keySize == 32 {
+		return s.Curve.String() + "-256"
+	}
+	return s.Curve.String()
+}
+
 func (s *SuiteEd25519) Read(r io.Reader, objs ...interface{}) error {
 	return fixbuf.Read(r, s, objs...)
 }
@@ -63,6 +77,16 @@ func (s *SuiteEd25519) NewKey(stream cipher.Stream) kyber.Scalar {
 // NewAES128SHA256Ed25519 returns a cipher suite based on AES-128, SHA-256, and
 // the Ed25519 curve.
 func NewAES128SHA256Ed25519() *SuiteEd25519 {
-	suite := new(SuiteEd25519)
-	return suite
+	return newSuite(16)
+}
+
+// NewAES256SHA256Ed25519 returns a cipher suite like NewAES128SHA256Ed25519,
+// but whose cipher takes 256-bit keys.
+func NewAES256SHA256Ed25519() *SuiteEd25519 {
+	return newSuite(32)
+}
+
+// newSuite returns a suite whose cipher takes keys of keySize bytes.
+func newSuite(keySize int) *SuiteEd25519 {
+	return &SuiteEd25519{keySize: keySize}
 }
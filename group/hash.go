@@ -0,0 +1,23 @@
+package group
+
+import (
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/hash"
+)
+
+// HashToScalar hashes the data into a scalar of g, e.g. to derive the
+// challenge of a Fiat-Shamir proof. It is hash.Scalar of the data, each slice
+// being one Raw input: each is framed by its length, so that no two different
+// lists of inputs hash the same way, and the result is close to uniform for
+// the groups whose order is much smaller than 2^512, such as the elliptic
+// curves. The hash of g is used if g is a HashFactory, such as the suites,
+// SHA-512 otherwise.
+func HashToScalar(g kyber.Group, data ...[]byte) kyber.Scalar {
+	inputs := make([]hash.Framed, len(data))
+	for i, d := range data {
+		inputs[i] = hash.Raw(d)
+	}
+	// writing bytes to a hash never fails
+	s, _ := hash.Scalar(g, inputs...)
+	return s
+}
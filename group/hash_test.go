@@ -0,0 +1,38 @@
+package group
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashToScalar(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	a := HashToScalar(suite, []byte("ab"), []byte("c"))
+	require.True(t, a.Equal(HashToScalar(suite, []byte("ab"), []byte("c"))))
+	require.False(t, a.Equal(HashToScalar(suite, []byte("a"), []byte("bc"))))
+	require.False(t, a.Equal(HashToScalar(suite, []byte("abc"))))
+	require.False(t, a.Equal(HashToScalar(suite, []byte("ab"), []byte("c"), nil)))
+
+	// groups without hash use SHA-512
+	plain := struct{ kyber.Group }{suite}
+	b := HashToScalar(plain, []byte("ab"), []byte("c"))
+	require.True(t, b.Equal(HashToScalar(plain, []byte("ab"), []byte("c"))))
+	require.False(t, a.Equal(b))
+
+	// the low bits of the scalars are evenly spread
+	n, buckets := 4096, make([]int, 16)
+	var counter [4]byte
+	for i := 0; i < n; i++ {
+		binary.BigEndian.PutUint32(counter[:], uint32(i))
+		buf, err := HashToScalar(suite, counter[:]).MarshalBinary()
+		require.Nil(t, err)
+		buckets[buf[0]&0x0f]++ // little-endian
+	}
+	for _, c := range buckets {
+		require.True(t, c > 160 && c < 352, "bucket of %d scalars out of %d", c, n)
+	}
+}
@@ -0,0 +1,35 @@
+// Package arith provides generic implementations of point arithmetic shared by
+// the groups, built on top of the kyber.Point interface.
+package arith
+
+import "github.com/dedis/kyber"
+
+// PointMulInt sets P to k*A with double-and-add and returns it. If A == nil,
+// it uses the base point. It only relies on Add, so it is cheaper than a full
+// scalar multiplication for small k, but it takes time depending on k.
+func PointMulInt(P kyber.Point, k int64, A kyber.Point) kyber.Point {
+	if A == nil {
+		A = P.Clone().Base()
+	} else {
+		A = A.Clone() // P and A may be the same point
+	}
+	u := uint64(k)
+	if k < 0 {
+		u = uint64(-k)
+	}
+	n := 0
+	for v := u; v != 0; v >>= 1 {
+		n++
+	}
+	P.Null()
+	for i := n - 1; i >= 0; i-- {
+		P.Add(P, P)
+		if (u>>uint(i))&1 == 1 {
+			P.Add(P, A)
+		}
+	}
+	if k < 0 {
+		P.Neg(P)
+	}
+	return P
+}
@@ -16,6 +16,9 @@ import (
 var one = big.NewInt(1)
 var two = big.NewInt(2)
 
+// number of bytes in a big.Word
+const wordBytes = (32 << (^uint(0) >> 63)) / 8
+
 // ByteOrder denotes what is the endianness used to represent an Int.
 type ByteOrder bool
 
@@ -177,6 +180,17 @@ func (i *Int) One() kyber.Scalar {
 	return i
 }
 
+// IsZero returns true if the Int is 0. Like all big.Int based operations,
+// it is not constant time.
+func (i *Int) IsZero() bool {
+	return i.V.Sign() == 0
+}
+
+// IsOne returns true if the Int is 1. It is not constant time.
+func (i *Int) IsOne() bool {
+	return i.V.Cmp(one) == 0
+}
+
 // SetInt64 sets the Int to an arbitrary 64-bit "small integer" value.
 // The modulus must already be initialized.
 func (i *Int) SetInt64(v int64) kyber.Scalar {
@@ -244,10 +258,27 @@ func (i *Int) Mul(a, b kyber.Scalar) kyber.Scalar {
 	return i
 }
 
+// MulAdd sets the target to a * b + c mod M, reducing only once.
+// The target's Modulus becomes a's Modulus.
+func (i *Int) MulAdd(a, b, c kyber.Scalar) kyber.Scalar {
+	ai := a.(*Int)
+	bi := b.(*Int)
+	ci := c.(*Int)
+	var t big.Int
+	t.Mul(&ai.V, &bi.V).Add(&t, &ci.V)
+	i.M = ai.M
+	i.V.Mod(&t, i.M)
+	return i
+}
+
 // Div sets the target to a * b^-1 mod M, where b^-1 is the modular inverse of b.
+// It panics if b is zero.
 func (i *Int) Div(a, b kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
 	bi := b.(*Int)
+	if bi.V.Sign() == 0 {
+		panic("mod.Int: division by zero")
+	}
 	var t big.Int
 	i.M = ai.M
 	i.V.Mul(&ai.V, t.ModInverse(&bi.V, i.M))
@@ -256,8 +287,12 @@ func (i *Int) Div(a, b kyber.Scalar) kyber.Scalar {
 }
 
 // Inv sets the target to the modular inverse of a with respect to modulus M.
+// It panics if a is zero, which has no inverse.
 func (i *Int) Inv(a kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
+	if ai.V.Sign() == 0 {
+		panic("mod.Int: inverse of zero")
+	}
 	i.M = ai.M
 	i.V.ModInverse(&a.(*Int).V, i.M)
 	return i
@@ -337,6 +372,29 @@ func (i *Int) MarshalBinary() ([]byte, error) {
 	return b, nil
 }
 
+// MarshalBinaryCT encodes the value of this Int exactly like MarshalBinary,
+// but always processes the full MarshalSize() width of the encoding,
+// independently of the number of significant bits of the value. It should be
+// preferred over MarshalBinary for secret values, at the cost of one extra
+// allocation and of touching every byte of the encoding even for small values.
+// Note that this only covers the serialization itself: big.Int arithmetic is
+// not constant time.
+func (i *Int) MarshalBinaryCT() ([]byte, error) {
+	l := i.MarshalSize()
+	words := make([]big.Word, (l+wordBytes-1)/wordBytes)
+	copy(words, i.V.Bits())
+	buf := make([]byte, l)
+	for j := 0; j < l; j++ {
+		b := byte(words[j/wordBytes] >> (8 * uint(j%wordBytes)))
+		if i.BO == LittleEndian {
+			buf[j] = b
+		} else {
+			buf[l-1-j] = b
+		}
+	}
+	return buf, nil
+}
+
 // UnmarshalBinary tries to decode a Int from a byte-slice buffer.
 // Returns an error if the buffer is not exactly Len() bytes long
 // or if the contents of the buffer represents an out-of-range integer.
@@ -355,6 +413,18 @@ func (i *Int) UnmarshalBinary(buf []byte) error {
 	return nil
 }
 
+// IsCanonical returns true if b is an encoding of the Int's length and byte
+// order whose value is lower than the modulus.
+func (i *Int) IsCanonical(b []byte) bool {
+	if len(b) != i.MarshalSize() {
+		return false
+	}
+	if i.BO == LittleEndian {
+		b = bytes.Reverse(nil, b)
+	}
+	return new(big.Int).SetBytes(b).Cmp(i.M) < 0
+}
+
 // MarshalTo encodes this Int to the given Writer.
 func (i *Int) MarshalTo(w io.Writer) (int, error) {
 	return marshalling.ScalarMarshalTo(i, w)
@@ -394,6 +464,12 @@ func (i *Int) SetBytes(a []byte) kyber.Scalar {
 	return i
 }
 
+// SetBytesWide sets the value to the 64-byte integer b, in the byte order of
+// i, reduced modulo M.
+func (i *Int) SetBytesWide(b [64]byte) kyber.Scalar {
+	return i.SetBytes(b[:])
+}
+
 // Bytes returns the variable length byte slice of the value.
 // It returns the byte slice using the same endianness as i.
 func (i *Int) Bytes() []byte {
@@ -404,6 +480,30 @@ func (i *Int) Bytes() []byte {
 	return buff
 }
 
+// LittleEndianBytes returns the little-endian encoding of the value of this
+// Int, exactly MarshalSize bytes long whatever its byte order BO.
+func (i *Int) LittleEndianBytes() []byte {
+	l := i.MarshalSize()
+	return i.LittleEndian(l, l)
+}
+
+// BigEndianBytes returns the big-endian encoding of the value of this Int,
+// exactly MarshalSize bytes long whatever its byte order BO.
+func (i *Int) BigEndianBytes() []byte {
+	return bytes.Reverse(nil, i.LittleEndianBytes())
+}
+
+// Bit returns the n-th bit of the value of this Int, bit 0 being the least
+// significant one. It panics if n is negative.
+func (i *Int) Bit(n int) uint {
+	return i.V.Bit(n)
+}
+
+// BitLen returns the length of the value of this Int in bits, 0 for zero.
+func (i *Int) BitLen() int {
+	return i.V.BitLen()
+}
+
 // LittleEndian encodes the value of this Int into a little-endian byte-slice
 // at least min bytes but no more than max bytes long.
 // Panics if max != 0 and the Int cannot be represented in max bytes.
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -63,6 +64,23 @@ func TestIntEndianBytes(t *testing.T) {
 
 	assert.Equal(t, 2, i.MarshalSize())
 	assert.NotPanics(t, func() { i.LittleEndian(2, 2) })
+	assert.Equal(t, []byte{0x10, 0}, i.LittleEndian(2, 2))
+	assert.Equal(t, []byte{0, 0x10}, i.BigEndianBytes())
+	assert.Equal(t, []byte{0x10, 0}, i.LittleEndianBytes())
+}
+
+func TestIntBits(t *testing.T) {
+	i := new(Int).Init64(0xb, big.NewInt(65535))
+	for n, bit := range []uint{1, 1, 0, 1, 0} {
+		assert.Equal(t, bit, i.Bit(n))
+	}
+	assert.Equal(t, 4, i.BitLen())
+	assert.Equal(t, uint(0), i.Bit(100))
+	assert.Equal(t, 0, new(Int).Init64(0, big.NewInt(65535)).BitLen())
+	// the byte order doesn't matter
+	i.BO = LittleEndian
+	assert.Equal(t, uint(1), i.Bit(3))
+	assert.Equal(t, 4, i.BitLen())
 }
 
 func TestInits(t *testing.T) {
@@ -85,3 +103,55 @@ func TestIntClone(t *testing.T) {
 		t.Error("Should not be equal")
 	}
 }
+
+func TestIntDivByZero(t *testing.T) {
+	modulo := big.NewInt(65537)
+	a := NewInt64(42, modulo)
+	zero := NewInt64(0, modulo)
+	assert.Panics(t, func() { new(Int).Inv(zero) })
+	assert.Panics(t, func() { new(Int).Div(a, zero) })
+	assert.True(t, new(Int).Div(a, a).Equal(NewInt64(1, modulo)))
+}
+
+func TestIntMarshalBinaryCT(t *testing.T) {
+	modulo, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(65535), new(big.Int).Sub(modulo, one)} {
+		for _, bo := range []ByteOrder{BigEndian, LittleEndian} {
+			i := NewInt(v, modulo)
+			i.BO = bo
+			ct, err := i.MarshalBinaryCT()
+			assert.Nil(t, err)
+			buff, err := i.MarshalBinary()
+			assert.Nil(t, err)
+			assert.Equal(t, buff, ct)
+		}
+	}
+}
+
+func TestIntMarshalBinaryCTTiming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test skipped in short mode")
+	}
+	modulo, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+	small := NewInt64(1, modulo)
+	large := NewInt(new(big.Int).Sub(modulo, one), modulo)
+	measure := func(i *Int) time.Duration {
+		start := time.Now()
+		for j := 0; j < 20000; j++ {
+			_, _ = i.MarshalBinaryCT()
+		}
+		return time.Since(start)
+	}
+	// best-effort: keep the fastest of several runs to reduce noise
+	var ds, dl time.Duration
+	for r := 0; r < 5; r++ {
+		if d := measure(small); ds == 0 || d < ds {
+			ds = d
+		}
+		if d := measure(large); dl == 0 || d < dl {
+			dl = d
+		}
+	}
+	ratio := float64(ds) / float64(dl)
+	assert.True(t, ratio > 0.5 && ratio < 2, "marshaling time depends on the value: %v vs %v", ds, dl)
+}
@@ -11,6 +11,7 @@ import (
 	"crypto/elliptic"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
 	"github.com/dedis/kyber/util/random"
@@ -174,6 +175,11 @@ func (p *curvePoint) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
 	return p
 }
 
+// MulInt multiplies point b by the small integer k.
+func (p *curvePoint) MulInt(k int64, b kyber.Point) kyber.Point {
+	return arith.PointMulInt(p, k, b)
+}
+
 func (p *curvePoint) MarshalSize() int {
 	coordlen := (p.c.Params().BitSize + 7) >> 3
 	return 1 + 2*coordlen // uncompressed ANSI X9.62 representation
@@ -246,6 +252,15 @@ func (c *curve) Scalar() kyber.Scalar {
 	return mod.NewInt64(0, c.p.N)
 }
 
+// sqrt3mod4 returns c^((m+1)/4) mod m, which is a square root of c
+// if there is one, for a prime modulus m = 3 (mod 4)
+// such as the P-384 and P-521 primes.
+func sqrt3mod4(c, m *big.Int) *big.Int {
+	e := new(big.Int).Add(m, big.NewInt(1))
+	e.Rsh(e, 2)
+	return new(big.Int).Exp(c, e, m)
+}
+
 // Number of bytes required to store one coordinate on this curve
 func (c *curve) coordLen() int {
 	return (c.p.BitSize + 7) / 8
@@ -265,6 +280,12 @@ func (c *curve) Point() kyber.Point {
 	return p
 }
 
+// Return the standard base point of this curve, as defined by the
+// curve parameters.
+func (c *curve) Generator() kyber.Point {
+	return c.Point().Base()
+}
+
 func (p *curvePoint) Set(P kyber.Point) kyber.Point {
 	p.x = P.(*curvePoint).x
 	p.y = P.(*curvePoint).y
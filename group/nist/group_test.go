@@ -3,9 +3,15 @@
 package nist
 
 import (
+	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
 )
 
 var testQR512 = NewAES128SHA256QR512()
@@ -17,10 +23,94 @@ var benchP256 = test.NewGroupBench(testP256)
 
 func TestP256(t *testing.T) { test.SuiteTest(testP256) }
 
+func TestPickDeterminism(t *testing.T) {
+	test.PickDeterminism(t, testP256)
+	test.PickDeterminism(t, NewAES256SHA384P384())
+	test.PickDeterminism(t, testQR512)
+}
+
+var testP384 = NewAES256SHA384P384()
+
+func TestP384(t *testing.T) { test.SuiteTest(testP384) }
+
+var testP521 = NewAES256SHA512P521()
+
+func TestP521(t *testing.T) { test.SuiteTest(testP521) }
+
+// Known answers for k*G, in uncompressed SEC1 encoding.
+var scalarMultKAT = []struct {
+	group kyber.Group
+	k     string
+	kG    string
+}{
+	{testP384, "02",
+		"0408d999057ba3d2d969260045c55b97f089025959a6f434d651d207d19fb96e9e4fe0e86ebe0e64f85b96a9c75295df618e80f1fa5b1b3cedb7bfe8dffd6dba74b275d875bc6cc43e904e505f256ab4255ffd43e94d39e22d61501e700a940e80"},
+	{testP384, "0123456789abcdef0123456789abcdef",
+		"04480fbbc7c2b3fa7e64e639a2e072d44f324f793b6ca1df92f50903ca5d50d6b662cf45a2170f2e809645045bae50fb2b7ebe2ae51d38bb1f40d171d72aee7f8fb81af12824436c726f57bd8929416e5f9c97719cc8dd4525e7e39cc0c839626c"},
+	{testP521, "02",
+		"0400433c219024277e7e682fcb288148c282747403279b1ccc06352c6e5505d769be97b3b204da6ef55507aa104a3a35c5af41cf2fa364d60fd967f43e3933ba6d783d00f4bb8cc7f86db26700a7f3eceeeed3f0b5c6b5107c4da97740ab21a29906c42dbbb3e377de9f251f6b93937fa99a3248f4eafcbe95edc0f4f71be356d661f41b02"},
+	{testP521, "0123456789abcdef0123456789abcdef",
+		"0401d002f99cf85a56f8e1935a1670179fe561125fd565afeb205d0de99459578afe4be800355ca3ff35a5e83da3d4af8e283ca642a4eb1ac6d54c1406435932d7f990000f8e00a3ff2b468b9e12d91393e00785294df981d7782998249cefa4f56a11898eac4d7d5813e9781bc5571cf3fa83c0843459f3add2d0e1b8cae5eb04820cfe9d"},
+}
+
+func TestScalarMultKAT(t *testing.T) {
+	for _, v := range scalarMultKAT {
+		k, err := hex.DecodeString(v.k)
+		require.Nil(t, err)
+		kG, err := hex.DecodeString(v.kG)
+		require.Nil(t, err)
+		s := v.group.Scalar().SetBytes(k)
+
+		p := v.group.Point().Mul(s, nil)
+		buf, err := p.MarshalBinary()
+		require.Nil(t, err)
+		require.Equal(t, kG, buf, v.group.String())
+
+		// same result with an explicit base point
+		require.True(t, p.Equal(v.group.Point().Mul(s, v.group.Point().Base())))
+
+		p2 := v.group.Point()
+		require.Nil(t, p2.UnmarshalBinary(kG))
+		require.True(t, p.Equal(p2))
+	}
+}
+
+func TestContracts(t *testing.T) {
+	for _, g := range []kyber.Group{testQR512, testP256, testP384, testP521} {
+		test.ScalarContract(t, g)
+		test.PointContract(t, g)
+	}
+}
+
+func TestScalarIsCanonical(t *testing.T) {
+	for _, g := range []kyber.Group{testP256, testP384, testP521} {
+		b := g.Scalar().(*mod.Int).M.Bytes()
+		s := g.Scalar()
+		require.Equal(t, s.MarshalSize(), len(b), g.String())
+		require.False(t, s.IsCanonical(b), g.String())
+		b[len(b)-1]--
+		require.True(t, s.IsCanonical(b), g.String())
+	}
+}
+
+func TestScalarSetBytesWide(t *testing.T) {
+	var b [64]byte
+	for _, g := range []kyber.Group{testP256, testP384, testP521} {
+		for i := 0; i < 20; i++ {
+			copy(b[:], random.Bytes(64, random.Stream))
+			s := g.Scalar().SetBytesWide(b).(*mod.Int)
+			ref := new(big.Int).SetBytes(b[:])
+			ref.Mod(ref, s.M)
+			require.Equal(t, 0, s.V.Cmp(ref), g.String())
+		}
+	}
+}
+
 func BenchmarkScalarAdd(b *testing.B)    { benchP256.ScalarAdd(b.N) }
 func BenchmarkScalarSub(b *testing.B)    { benchP256.ScalarSub(b.N) }
 func BenchmarkScalarNeg(b *testing.B)    { benchP256.ScalarNeg(b.N) }
 func BenchmarkScalarMul(b *testing.B)    { benchP256.ScalarMul(b.N) }
+func BenchmarkScalarMulAdd(b *testing.B) { benchP256.ScalarMulAdd(b.N) }
 func BenchmarkScalarDiv(b *testing.B)    { benchP256.ScalarDiv(b.N) }
 func BenchmarkScalarInv(b *testing.B)    { benchP256.ScalarInv(b.N) }
 func BenchmarkScalarPick(b *testing.B)   { benchP256.ScalarPick(b.N) }
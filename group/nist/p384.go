@@ -0,0 +1,31 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// P384 implements the kyber.Group interface
+// for the NIST P-384 elliptic curve,
+// based on Go's native elliptic curve library.
+type p384 struct {
+	curve
+}
+
+func (curve *p384) String() string {
+	return "P384"
+}
+
+func (curve *p384) sqrt(c *big.Int) *big.Int {
+	return sqrt3mod4(c, curve.p.P)
+}
+
+// Initialize standard Curve instances
+func (c *p384) Init() curve {
+	c.curve.Curve = elliptic.P384()
+	c.p = c.Params()
+	c.curveOps = c
+	return c.curve
+}
@@ -0,0 +1,31 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// P521 implements the kyber.Group interface
+// for the NIST P-521 elliptic curve,
+// based on Go's native elliptic curve library.
+type p521 struct {
+	curve
+}
+
+func (curve *p521) String() string {
+	return "P521"
+}
+
+func (curve *p521) sqrt(c *big.Int) *big.Int {
+	return sqrt3mod4(c, curve.p.P)
+}
+
+// Initialize standard Curve instances
+func (c *p521) Init() curve {
+	c.curve.Curve = elliptic.P521()
+	c.p = c.Params()
+	c.curveOps = c
+	return c.curve
+}
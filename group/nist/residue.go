@@ -12,6 +12,7 @@ import (
 	//"encoding/hex"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/internal/arith"
 	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
 	"github.com/dedis/kyber/util/random"
@@ -51,12 +52,12 @@ func (p *residuePoint) Base() kyber.Point {
 
 func (p *residuePoint) Set(p2 kyber.Point) kyber.Point {
 	p.g = p2.(*residuePoint).g
-	p.Int = p2.(*residuePoint).Int
+	p.Int.Set(&p2.(*residuePoint).Int)
 	return p
 }
 
 func (p *residuePoint) Clone() kyber.Point {
-	return &residuePoint{g: p.g, Int: p.Int}
+	return new(residuePoint).Set(p)
 }
 
 func (p *residuePoint) Valid() bool {
@@ -126,7 +127,8 @@ func (p *residuePoint) Sub(a, b kyber.Point) kyber.Point {
 }
 
 func (p *residuePoint) Neg(a kyber.Point) kyber.Point {
-	p.Int.ModInverse(&a.(*residuePoint).Int, p.g.P)
+	// ModInverse doesn't support aliasing its arguments
+	p.Int.Set(new(big.Int).ModInverse(&a.(*residuePoint).Int, p.g.P))
 	return p
 }
 
@@ -138,6 +140,11 @@ func (p *residuePoint) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
 	return p
 }
 
+// MulInt multiplies point b by the small integer k.
+func (p *residuePoint) MulInt(k int64, b kyber.Point) kyber.Point {
+	return arith.PointMulInt(p, k, b)
+}
+
 func (p *residuePoint) MarshalSize() int {
 	return (p.g.P.BitLen() + 7) / 8
 }
@@ -235,6 +242,11 @@ func (g *ResidueGroup) Point() kyber.Point {
 	return p
 }
 
+// Return the generator G of this Residue group, as given by its parameters.
+func (g *ResidueGroup) Generator() kyber.Point {
+	return g.Point().Base()
+}
+
 // Returns the order of this Residue group, namely the prime Q.
 func (g *ResidueGroup) Order() *big.Int {
 	return g.Q
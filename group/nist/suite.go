@@ -5,6 +5,7 @@ package nist
 import (
 	"crypto/cipher"
 	"crypto/sha256"
+	"crypto/sha512"
 	"hash"
 	"io"
 	"reflect"
@@ -56,3 +57,87 @@ func NewAES128SHA256P256() *Suite128 {
 	suite.p256.Init()
 	return suite
 }
+
+// SuiteP384 is the ciphersuite of the NIST P-384 elliptic curve, with
+// SHA-384 and SHAKE256, created by NewAES256SHA384P384.
+type SuiteP384 struct {
+	p384
+}
+
+// SHA384 hash function
+func (s *SuiteP384) Hash() hash.Hash {
+	return sha512.New384()
+}
+
+// SHA3/SHAKE256 Sponge Cipher
+func (s *SuiteP384) Cipher(key []byte, options ...interface{}) kyber.Cipher {
+	return sha3.NewShakeCipher256(key, options...)
+}
+
+func (s *SuiteP384) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs)
+}
+
+func (s *SuiteP384) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *SuiteP384) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+func (s *SuiteP384) NewKey(rand cipher.Stream) kyber.Scalar {
+	if rand == nil {
+		rand = random.Stream
+	}
+	return s.Scalar().Pick(rand)
+}
+
+// Ciphersuite based on AES-256, SHA-384, and the NIST P-384 elliptic curve.
+func NewAES256SHA384P384() *SuiteP384 {
+	suite := new(SuiteP384)
+	suite.p384.Init()
+	return suite
+}
+
+// SuiteP521 is the ciphersuite of the NIST P-521 elliptic curve, with
+// SHA-512 and SHAKE256, created by NewAES256SHA512P521.
+type SuiteP521 struct {
+	p521
+}
+
+// SHA512 hash function
+func (s *SuiteP521) Hash() hash.Hash {
+	return sha512.New()
+}
+
+// SHA3/SHAKE256 Sponge Cipher
+func (s *SuiteP521) Cipher(key []byte, options ...interface{}) kyber.Cipher {
+	return sha3.NewShakeCipher256(key, options...)
+}
+
+func (s *SuiteP521) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs)
+}
+
+func (s *SuiteP521) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *SuiteP521) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+func (s *SuiteP521) NewKey(rand cipher.Stream) kyber.Scalar {
+	if rand == nil {
+		rand = random.Stream
+	}
+	return s.Scalar().Pick(rand)
+}
+
+// Ciphersuite based on AES-256, SHA-512, and the NIST P-521 elliptic curve.
+func NewAES256SHA512P521() *SuiteP521 {
+	suite := new(SuiteP521)
+	suite.p521.Init()
+	return suite
+}
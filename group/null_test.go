@@ -0,0 +1,27 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullRoundTrip(t *testing.T) {
+	for _, name := range registry.Names() {
+		s := Suite(name)
+		g := s.(kyber.Group)
+		buf, err := g.Point().Null().MarshalBinary()
+		require.Nil(t, err, name)
+		P := g.Point().Base()
+		require.Nil(t, P.UnmarshalBinary(buf), name)
+		require.True(t, P.Equal(g.Point().Null()), name)
+
+		// as does the encoding of an identity obtained by arithmetic
+		G := g.Point().Base()
+		b, err := g.Point().Sub(G, G).MarshalBinary()
+		require.Nil(t, err, name)
+		require.Equal(t, buf, b, name)
+	}
+}
@@ -0,0 +1,99 @@
+package group
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// aliases maps the short names under which groups are commonly known to the
+// name of the suite they are registered under.
+var aliases = map[string]string{
+	"ed25519":      "ed25519",
+	"edwards25519": "ed25519",
+	"ed25519-256":  "ed25519-256",
+	"25519":        "25519",
+	"curve25519":   "25519",
+	"p256":         "p256",
+	"p-256":        "p256",
+	"secp256r1":    "p256",
+	"prime256v1":   "p256",
+	"p384":         "p384",
+	"p-384":        "p384",
+	"secp384r1":    "p384",
+	"p521":         "p521",
+	"p-521":        "p521",
+	"secp521r1":    "p521",
+	"residue512":   "residue512",
+	"qr512":        "residue512",
+}
+
+// descriptive maps the descriptive names of the suites, listing their cipher,
+// hash and group, to the name they are registered under. The group is given
+// by its registered name, to which the aliases are resolved before the
+// lookup, and the components are sorted so that their order doesn't matter.
+var descriptive = map[string]string{}
+
+func init() {
+	for name, suite := range map[string]string{
+		"aes128+sha256+ed25519":    "ed25519",
+		"aes256+sha256+ed25519":    "ed25519-256",
+		"aes128+sha256+25519":      "25519",
+		"aes128+sha256+p256":       "p256",
+		"aes256+sha384+p384":       "p384",
+		"aes256+sha512+p521":       "p521",
+		"aes128+sha256+residue512": "residue512",
+	} {
+		descriptive[sortedComponents(strings.Split(name, "+"))] = suite
+	}
+}
+
+var separators = strings.NewReplacer("_", "+", ",", "+", "/", "+", " ", "+")
+var version = regexp.MustCompile(`^v[0-9]+(\.[0-9]+)*$`)
+
+// CanonicalSuiteName returns the name under which the suite designated by
+// name is registered. It accepts short aliases such as "ed25519" or
+// "secp256r1", and descriptive names listing the cipher, hash and group of
+// the suite, such as "AES128+SHA256+Ed25519", in any case and order and
+// separated by '+', '_', ',', '/' or spaces. Version components, like "v1",
+// are ignored.
+func CanonicalSuiteName(name string) (string, error) {
+	var components []string
+	for _, c := range strings.Split(separators.Replace(strings.ToLower(name)), "+") {
+		if c != "" && !version.MatchString(c) {
+			components = append(components, c)
+		}
+	}
+	if len(components) == 1 {
+		if s, ok := aliases[components[0]]; ok {
+			return s, nil
+		}
+	}
+	for i, c := range components {
+		if s, ok := aliases[c]; ok {
+			components[i] = s
+		}
+	}
+	if s, ok := descriptive[sortedComponents(components)]; ok {
+		return s, nil
+	}
+	return "", errors.New("group: unknown suite name " + name)
+}
+
+// ParseSuite returns the registered suite designated by name, as understood
+// by CanonicalSuiteName. Unlike Lookup, which only knows the registered
+// names, it is liberal in what it accepts, which suits command line tools.
+func ParseSuite(name string) (interface{}, error) {
+	canonical, err := CanonicalSuiteName(name)
+	if err != nil {
+		return nil, err
+	}
+	return Lookup(canonical)
+}
+
+func sortedComponents(components []string) string {
+	sorted := append([]string{}, components...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, "+")
+}
@@ -0,0 +1,37 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuite(t *testing.T) {
+	ed25519 := Suite("ed25519")
+	for _, name := range []string{
+		"ed25519",
+		"Ed25519",
+		" edwards25519 ",
+		"AES128+SHA256+Ed25519",
+		"aes128_sha256_ed25519",
+		"SHA256, AES128, Ed25519",
+		"AES128+SHA256+Ed25519+v1",
+		"ed25519/v2.1",
+	} {
+		s, err := ParseSuite(name)
+		require.Nil(t, err, name)
+		require.True(t, s == ed25519, name)
+	}
+
+	ed25519256 := Suite("ed25519-256")
+	for _, name := range []string{"ed25519-256", "AES256+SHA256+Ed25519", "aes256 sha256 edwards25519"} {
+		s, err := ParseSuite(name)
+		require.Nil(t, err, name)
+		require.True(t, s == ed25519256, name)
+	}
+
+	for _, name := range []string{"", "v1", "ed448", "AES192+SHA256+Ed25519", "AES128+SHA256", "ed25519+ed25519"} {
+		_, err := ParseSuite(name)
+		require.NotNil(t, err, name)
+	}
+}
@@ -0,0 +1,27 @@
+// +build vartime
+
+package group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuiteVartime(t *testing.T) {
+	for canonical, names := range map[string][]string{
+		"p256":       {"P256", "P-256", "secp256r1", "prime256v1", "AES128+SHA256+P256"},
+		"p384":       {"secp384r1", "AES256_SHA384_P-384"},
+		"p521":       {"p-521", "AES256+SHA512+secp521r1"},
+		"25519":      {"curve25519", "AES128+SHA256+Curve25519"},
+		"residue512": {"QR512", "AES128+SHA256+QR512"},
+	} {
+		for _, name := range names {
+			s, err := ParseSuite(name)
+			require.Nil(t, err, name)
+			require.True(t, s == Suite(canonical), name)
+		}
+	}
+	_, err := ParseSuite("AES128+SHA256+P384")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,47 @@
+package group
+
+import (
+	"encoding/binary"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/cipher/sha3"
+)
+
+// generatorsDomain separates the derivation of IndependentGenerators from
+// other uses of SHAKE256.
+var generatorsDomain = []byte("kyber-independent-generators")
+
+// PointsEqual tells whether a and b hold equal points in the same order. It
+// returns at the first difference, so its running time leaks the position of
+// that difference: it is meant for public values, such as checking that a
+// re-encryption or a shuffle preserved the order of the ciphertexts.
+func PointsEqual(a, b []kyber.Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IndependentGenerators derives k generators of the group from the label,
+// e.g. for Pedersen vector commitments, such that nobody knows a discrete
+// logarithm relation between them, or with the standard base point. The i-th
+// one is picked from the output of SHAKE256 over the label and i, so that it
+// does not depend on k, and the same label always gives the same generators.
+// Different commitment schemes should use different labels.
+func IndependentGenerators(g kyber.Group, label []byte, k int) []kyber.Point {
+	gens := make([]kyber.Point, k)
+	var index [4]byte
+	for i := range gens {
+		binary.BigEndian.PutUint32(index[:], uint32(i))
+		stream := sha3.NewShakeCipher256(generatorsDomain)
+		stream.Message(nil, nil, label)
+		stream.Message(nil, nil, index[:])
+		gens[i] = g.Point().Pick(stream)
+	}
+	return gens
+}
@@ -0,0 +1,56 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointsEqual(t *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	a := make([]kyber.Point, 4)
+	b := make([]kyber.Point, 4)
+	for i := range a {
+		a[i] = g.Point().Pick(random.Stream)
+		b[i] = a[i].Clone()
+	}
+	require.True(t, PointsEqual(a, b))
+	require.True(t, PointsEqual(nil, []kyber.Point{}))
+
+	reordered := []kyber.Point{b[1], b[0], b[2], b[3]}
+	require.False(t, PointsEqual(a, reordered))
+
+	require.False(t, PointsEqual(a, b[:3]))
+	require.False(t, PointsEqual(a[:3], b))
+
+	b[3] = g.Point().Add(b[3], g.Point().Base())
+	require.False(t, PointsEqual(a, b))
+}
+
+func TestIndependentGenerators(t *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	k := 8
+	gens := IndependentGenerators(g, []byte("pedersen"), k)
+	require.Equal(t, k, len(gens))
+	for i := range gens {
+		require.False(t, gens[i].Equal(g.Point().Base()))
+		require.False(t, gens[i].Equal(g.Point().Null()))
+		for j := range gens[:i] {
+			require.False(t, gens[i].Equal(gens[j]), "generators %d and %d", i, j)
+		}
+	}
+
+	// deterministic, and independent of the number of generators
+	require.True(t, PointsEqual(gens, IndependentGenerators(g, []byte("pedersen"), k)))
+	require.True(t, PointsEqual(gens[:3], IndependentGenerators(g, []byte("pedersen"), 3)))
+
+	// another label gives other generators
+	other := IndependentGenerators(g, []byte("bulletproofs"), k)
+	for i := range gens {
+		require.False(t, gens[i].Equal(other[i]))
+	}
+	require.Equal(t, 0, len(IndependentGenerators(g, []byte("pedersen"), 0)))
+}
@@ -0,0 +1,48 @@
+package group
+
+import (
+	"sync"
+
+	"github.com/dedis/kyber"
+)
+
+// Pool recycles the scalars and points of a group, to cut the allocations of
+// hot loops that would otherwise call g.Scalar() and g.Point() on each
+// iteration. Elements are reset when they are put back, to the zero scalar
+// and the neutral element, so that no secret value is handed out to the
+// next user. A Pool is safe for concurrent use.
+type Pool struct {
+	scalars sync.Pool
+	points  sync.Pool
+}
+
+// NewPool returns a pool of the elements of g.
+func NewPool(g kyber.Group) *Pool {
+	p := &Pool{}
+	p.scalars.New = func() interface{} { return g.Scalar() }
+	p.points.New = func() interface{} { return g.Point() }
+	return p
+}
+
+// GetScalar returns a scalar set to zero, either recycled or newly allocated.
+func (p *Pool) GetScalar() kyber.Scalar {
+	return p.scalars.Get().(kyber.Scalar)
+}
+
+// PutScalar zeroes s and makes it available to GetScalar. The caller must
+// not use s afterwards.
+func (p *Pool) PutScalar(s kyber.Scalar) {
+	p.scalars.Put(s.Zero())
+}
+
+// GetPoint returns a point set to the neutral element, either recycled or
+// newly allocated.
+func (p *Pool) GetPoint() kyber.Point {
+	return p.points.Get().(kyber.Point).Null()
+}
+
+// PutPoint resets P to the neutral element and makes it available to
+// GetPoint. The caller must not use P afterwards.
+func (p *Pool) PutPoint(P kyber.Point) {
+	p.points.Put(P.Null())
+}
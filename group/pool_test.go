@@ -0,0 +1,77 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolZeroes(t *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	pool := NewPool(g)
+	for i := 0; i < 100; i++ {
+		s := pool.GetScalar()
+		require.True(t, s.IsZero())
+		s.Pick(random.Stream)
+		pool.PutScalar(s)
+
+		P := pool.GetPoint()
+		require.True(t, P.Equal(g.Point().Null()))
+		P.Pick(random.Stream)
+		pool.PutPoint(P)
+	}
+	// a scalar put back is zeroed even if it is retained by the caller
+	s := g.Scalar().Pick(random.Stream)
+	pool.PutScalar(s)
+	require.True(t, s.IsZero())
+}
+
+var benchPoolSuite = edwards25519.NewAES128SHA256Ed25519()
+
+// sinks keep the results alive, as in a real loop, so that the fresh
+// elements are heap allocated
+var scalarSink kyber.Scalar
+var pointSink kyber.Point
+
+func BenchmarkScalarFresh(b *testing.B) {
+	x := benchPoolSuite.Scalar().Pick(random.Stream)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := benchPoolSuite.Scalar()
+		scalarSink = s.Mul(x, x)
+	}
+}
+
+func BenchmarkScalarPooled(b *testing.B) {
+	pool := NewPool(benchPoolSuite)
+	x := benchPoolSuite.Scalar().Pick(random.Stream)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := pool.GetScalar()
+		scalarSink = s.Mul(x, x)
+		pool.PutScalar(s)
+	}
+}
+
+func BenchmarkPointFresh(b *testing.B) {
+	X := benchPoolSuite.Point().Pick(random.Stream)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		P := benchPoolSuite.Point()
+		pointSink = P.Add(X, X)
+	}
+}
+
+func BenchmarkPointPooled(b *testing.B) {
+	pool := NewPool(benchPoolSuite)
+	X := benchPoolSuite.Point().Pick(random.Stream)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		P := pool.GetPoint()
+		pointSink = P.Add(X, X)
+		pool.PutPoint(P)
+	}
+}
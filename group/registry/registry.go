@@ -0,0 +1,46 @@
+// Package registry holds the suites known by name to the kyber packages. The
+// group package registers the suites it implements when it is imported, and
+// resolves their names here, as do the packages which can't import it, such
+// as util/key.
+package registry
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dedis/kyber"
+)
+
+var lock sync.RWMutex
+var suites = map[string]kyber.Group{}
+
+// Register adds the suite under its lowercase name, replacing any suite of
+// the same name. group.RegisterSuite checks the suite before registering it
+// and should be preferred.
+func Register(s kyber.Group) {
+	lock.Lock()
+	defer lock.Unlock()
+	suites[strings.ToLower(s.String())] = s
+}
+
+// Lookup returns the suite registered under the given name, which is case
+// insensitive, and whether there is one.
+func Lookup(name string) (kyber.Group, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	s, ok := suites[strings.ToLower(name)]
+	return s, ok
+}
+
+// Names returns the sorted lowercase names of the registered suites.
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
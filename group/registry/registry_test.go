@@ -0,0 +1,20 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	Register(suite)
+	s, ok := Lookup("ED25519")
+	require.True(t, ok)
+	require.True(t, s == suite)
+	require.Equal(t, []string{"ed25519"}, Names())
+
+	_, ok = Lookup("ed448")
+	require.False(t, ok)
+}
@@ -0,0 +1,41 @@
+package group
+
+import (
+	"math/big"
+
+	"github.com/dedis/kyber"
+)
+
+// Sqrt returns a square root of the scalar a modulo the order of g, and true,
+// if a is a quadratic residue. Otherwise, or if g is not of prime order, it
+// returns nil and false. The root is computed with math/big, which uses the
+// p = 3 (mod 4) and p = 5 (mod 8) shortcuts when possible and Tonelli-Shanks
+// otherwise. It is not constant time.
+func Sqrt(g kyber.Group, a kyber.Scalar) (kyber.Scalar, bool) {
+	if !g.PrimeOrder() {
+		return nil, false
+	}
+	// the order is one more than the largest scalar, -1
+	buf, err := kyber.CanonicalScalarBytes(g, g.Scalar().SetInt64(-1))
+	if err != nil {
+		return nil, false
+	}
+	order := new(big.Int).SetBytes(buf)
+	order.Add(order, big.NewInt(1))
+
+	if buf, err = kyber.CanonicalScalarBytes(g, a); err != nil {
+		return nil, false
+	}
+	root := new(big.Int).ModSqrt(new(big.Int).SetBytes(buf), order)
+	if root == nil {
+		return nil, false
+	}
+	rb := root.Bytes()
+	buf = make([]byte, g.ScalarLen())
+	copy(buf[len(buf)-len(rb):], rb)
+	s, err := kyber.SetCanonicalScalarBytes(g, buf)
+	if err != nil {
+		return nil, false
+	}
+	return s, true
+}
@@ -0,0 +1,39 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func testSqrt(t *testing.T, g kyber.Group, nonResidues []int64) {
+	four := g.Scalar().SetInt64(4)
+	root, ok := Sqrt(g, four)
+	require.True(t, ok)
+	two := g.Scalar().SetInt64(2)
+	require.True(t, root.Equal(two) || root.Equal(g.Scalar().Neg(two)))
+
+	root, ok = Sqrt(g, g.Scalar().Zero())
+	require.True(t, ok)
+	require.True(t, root.IsZero())
+
+	for i := 0; i < 20; i++ {
+		s := g.Scalar().Pick(random.Stream)
+		square := g.Scalar().Mul(s, s)
+		root, ok := Sqrt(g, square)
+		require.True(t, ok)
+		require.True(t, g.Scalar().Mul(root, root).Equal(square))
+	}
+
+	for _, v := range nonResidues {
+		_, ok := Sqrt(g, g.Scalar().SetInt64(v))
+		require.False(t, ok)
+	}
+}
+
+func TestSqrtEd25519(t *testing.T) {
+	testSqrt(t, edwards25519.NewAES128SHA256Ed25519(), []int64{2, 6, 7})
+}
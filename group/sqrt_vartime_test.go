@@ -0,0 +1,13 @@
+// +build vartime
+
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/nist"
+)
+
+func TestSqrtP256(t *testing.T) {
+	testSqrt(t, nist.NewAES128SHA256P256(), []int64{7, 11, 13})
+}
@@ -0,0 +1,53 @@
+package group
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/registry"
+)
+
+// MarshalTagged returns the binary representation of the point p prefixed by
+// the name of the suite it belongs to. The encoding is made of one byte
+// holding the length of the lowercase suite name, the name itself and the
+// marshalled point. Such a self-describing point can be decoded with
+// UnmarshalTagged without knowing the suite beforehand. It returns an error
+// if the suite is not registered in this package.
+func MarshalTagged(suite kyber.Group, p kyber.Point) ([]byte, error) {
+	name := strings.ToLower(suite.String())
+	if _, ok := registry.Lookup(name); !ok {
+		return nil, errors.New("group: no suite named " + suite.String())
+	}
+	if len(name) > 255 {
+		return nil, errors.New("group: suite name too long")
+	}
+	buff, err := p.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	tagged := make([]byte, 0, 1+len(name)+len(buff))
+	tagged = append(tagged, byte(len(name)))
+	tagged = append(tagged, name...)
+	return append(tagged, buff...), nil
+}
+
+// UnmarshalTagged decodes a point encoded with MarshalTagged. The suite is
+// resolved from the name embedded in data. It returns an error if the suite is
+// unknown or if the point can not be decoded.
+func UnmarshalTagged(data []byte) (kyber.Point, error) {
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return nil, errors.New("group: tagged point too short")
+	}
+	l := int(data[0])
+	name := string(data[1 : 1+l])
+	g, ok := registry.Lookup(name)
+	if !ok {
+		return nil, errors.New("group: no suite named " + name)
+	}
+	p := g.Point()
+	if err := p.UnmarshalBinary(data[1+l:]); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
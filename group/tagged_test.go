@@ -0,0 +1,57 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func testTaggedRoundTrip(t *testing.T, g kyber.Group) {
+	p := g.Point().Pick(random.Stream)
+	buff, err := MarshalTagged(g, p)
+	require.Nil(t, err)
+	p2, err := UnmarshalTagged(buff)
+	require.Nil(t, err)
+	require.True(t, p.Equal(p2))
+}
+
+func TestTaggedEd25519(t *testing.T) {
+	testTaggedRoundTrip(t, Suite("Ed25519").(kyber.Group))
+}
+
+func TestTaggedErrors(t *testing.T) {
+	g := Suite("Ed25519").(kyber.Group)
+	buff, err := MarshalTagged(g, g.Point().Base())
+	require.Nil(t, err)
+
+	_, err = UnmarshalTagged(nil)
+	require.Error(t, err)
+	_, err = UnmarshalTagged(buff[:3])
+	require.Error(t, err)
+	_, err = UnmarshalTagged(buff[:len(buff)-1])
+	require.Error(t, err)
+
+	unknown := append([]byte{}, buff...)
+	unknown[1] = 'x'
+	_, err = UnmarshalTagged(unknown)
+	require.Error(t, err)
+}
+
+func TestTaggedConcurrentRegister(t *testing.T) {
+	g := Suite("Ed25519").(kyber.Suite)
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			if err := RegisterSuite(g); err != nil {
+				panic(err)
+			}
+		}
+		close(done)
+	}()
+	for i := 0; i < 100; i++ {
+		testTaggedRoundTrip(t, g)
+	}
+	<-done
+}
@@ -0,0 +1,29 @@
+// +build vartime
+
+package group
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaggedAcrossSuites(t *testing.T) {
+	ed25519 := Suite("Ed25519").(kyber.Group)
+	p256 := Suite("P256").(kyber.Group)
+	testTaggedRoundTrip(t, ed25519)
+	testTaggedRoundTrip(t, p256)
+
+	// points of both suites can be decoded from the same channel
+	b1, err := MarshalTagged(ed25519, ed25519.Point().Base())
+	require.Nil(t, err)
+	b2, err := MarshalTagged(p256, p256.Point().Base())
+	require.Nil(t, err)
+	p1, err := UnmarshalTagged(b1)
+	require.Nil(t, err)
+	p2, err := UnmarshalTagged(b2)
+	require.Nil(t, err)
+	require.True(t, ed25519.Point().Base().Equal(p1))
+	require.True(t, p256.Point().Base().Equal(p2))
+}
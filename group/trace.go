@@ -0,0 +1,313 @@
+package group
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+
+	"github.com/dedis/kyber"
+)
+
+// Trace returns a group behaving like g that logs every Scalar and Point
+// operation to w, one line per operation with its operands and result in hex,
+// for example:
+//
+//	Point.Mul(0500..., base) = 5866...
+//
+// It is meant for teaching and for debugging failing proofs, never for
+// production: the log contains every secret scalar. If g also provides
+// hashes, ciphers and encodings, like the suites, so does the returned group,
+// so that it can be given to the protocols expecting a suite, to record e.g.
+// a PVSS verification. Optional interfaces of the points and scalars of g,
+// such as kyber.Hiding, are not available through the trace.
+func Trace(g kyber.Group, w io.Writer) kyber.Group {
+	t := &traceGroup{Group: g, w: w}
+	if s, ok := g.(traceable); ok {
+		return &traceSuite{t, s}
+	}
+	return t
+}
+
+// traceable is what the suites provide besides the group.
+type traceable interface {
+	kyber.HashFactory
+	kyber.CipherFactory
+	kyber.Encoding
+}
+
+type traceGroup struct {
+	kyber.Group
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// log writes the line of an operation on a value of the given type, e.g.
+// "Scalar", with its result.
+func (t *traceGroup) log(typ, op string, res interface{}, args ...interface{}) {
+	ops := ""
+	for i, a := range args {
+		if i > 0 {
+			ops += ", "
+		}
+		ops += traceString(a)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.w, "%s.%s(%s) = %s\n", typ, op, ops, traceString(res))
+}
+
+// traceString formats an operand: points and scalars in hex, a nil point as
+// the base point used by Point.Mul.
+func traceString(a interface{}) string {
+	switch v := a.(type) {
+	case kyber.Marshaling:
+		return traceHex(v)
+	case []byte:
+		return hex.EncodeToString(v)
+	case nil:
+		return "base"
+	}
+	return fmt.Sprint(a)
+}
+
+func traceHex(m kyber.Marshaling) string {
+	buf, err := m.MarshalBinary()
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return hex.EncodeToString(buf)
+}
+
+func (t *traceGroup) Scalar() kyber.Scalar {
+	return &traceScalar{t.Group.Scalar(), t}
+}
+
+func (t *traceGroup) Point() kyber.Point {
+	return &tracePoint{t.Group.Point(), t}
+}
+
+func (t *traceGroup) Generator() kyber.Point {
+	return &tracePoint{t.Group.Generator(), t}
+}
+
+func (t *traceGroup) NewKey(rand cipher.Stream) kyber.Scalar {
+	s := &traceScalar{t.Group.NewKey(rand), t}
+	t.log("Group", "NewKey", s)
+	return s
+}
+
+type traceSuite struct {
+	*traceGroup
+	s traceable
+}
+
+func (t *traceSuite) Hash() hash.Hash {
+	return t.s.Hash()
+}
+
+func (t *traceSuite) Cipher(key []byte, options ...interface{}) kyber.Cipher {
+	return t.s.Cipher(key, options...)
+}
+
+func (t *traceSuite) Write(w io.Writer, objs ...interface{}) error {
+	return t.s.Write(w, objs...)
+}
+
+func (t *traceSuite) Read(r io.Reader, objs ...interface{}) error {
+	return t.s.Read(r, objs...)
+}
+
+// traceScalar wraps a scalar of the traced group.
+type traceScalar struct {
+	kyber.Scalar
+	t *traceGroup
+}
+
+// scalar returns the underlying scalar of s.
+func scalar(s kyber.Scalar) kyber.Scalar {
+	if ts, ok := s.(*traceScalar); ok {
+		return ts.Scalar
+	}
+	return s
+}
+
+func (s *traceScalar) Equal(a kyber.Scalar) bool {
+	eq := s.Scalar.Equal(scalar(a))
+	s.t.log("Scalar", "Equal", eq, s, a)
+	return eq
+}
+
+func (s *traceScalar) Set(a kyber.Scalar) kyber.Scalar {
+	s.Scalar.Set(scalar(a))
+	s.t.log("Scalar", "Set", s, a)
+	return s
+}
+
+func (s *traceScalar) Clone() kyber.Scalar {
+	return &traceScalar{s.Scalar.Clone(), s.t}
+}
+
+func (s *traceScalar) SetInt64(v int64) kyber.Scalar {
+	s.Scalar.SetInt64(v)
+	s.t.log("Scalar", "SetInt64", s, v)
+	return s
+}
+
+func (s *traceScalar) Zero() kyber.Scalar {
+	s.Scalar.Zero()
+	s.t.log("Scalar", "Zero", s)
+	return s
+}
+
+func (s *traceScalar) One() kyber.Scalar {
+	s.Scalar.One()
+	s.t.log("Scalar", "One", s)
+	return s
+}
+
+func (s *traceScalar) Add(a, b kyber.Scalar) kyber.Scalar {
+	s.Scalar.Add(scalar(a), scalar(b))
+	s.t.log("Scalar", "Add", s, a, b)
+	return s
+}
+
+func (s *traceScalar) Sub(a, b kyber.Scalar) kyber.Scalar {
+	s.Scalar.Sub(scalar(a), scalar(b))
+	s.t.log("Scalar", "Sub", s, a, b)
+	return s
+}
+
+func (s *traceScalar) Neg(a kyber.Scalar) kyber.Scalar {
+	s.Scalar.Neg(scalar(a))
+	s.t.log("Scalar", "Neg", s, a)
+	return s
+}
+
+func (s *traceScalar) Mul(a, b kyber.Scalar) kyber.Scalar {
+	s.Scalar.Mul(scalar(a), scalar(b))
+	s.t.log("Scalar", "Mul", s, a, b)
+	return s
+}
+
+func (s *traceScalar) MulAdd(a, b, c kyber.Scalar) kyber.Scalar {
+	s.Scalar.MulAdd(scalar(a), scalar(b), scalar(c))
+	s.t.log("Scalar", "MulAdd", s, a, b, c)
+	return s
+}
+
+func (s *traceScalar) Div(a, b kyber.Scalar) kyber.Scalar {
+	s.Scalar.Div(scalar(a), scalar(b))
+	s.t.log("Scalar", "Div", s, a, b)
+	return s
+}
+
+func (s *traceScalar) Inv(a kyber.Scalar) kyber.Scalar {
+	s.Scalar.Inv(scalar(a))
+	s.t.log("Scalar", "Inv", s, a)
+	return s
+}
+
+func (s *traceScalar) Pick(rand cipher.Stream) kyber.Scalar {
+	s.Scalar.Pick(rand)
+	s.t.log("Scalar", "Pick", s)
+	return s
+}
+
+func (s *traceScalar) SetBytes(b []byte) kyber.Scalar {
+	s.Scalar.SetBytes(b)
+	s.t.log("Scalar", "SetBytes", s, b)
+	return s
+}
+
+func (s *traceScalar) SetBytesWide(b [64]byte) kyber.Scalar {
+	s.Scalar.SetBytesWide(b)
+	s.t.log("Scalar", "SetBytesWide", s, b[:])
+	return s
+}
+
+// tracePoint wraps a point of the traced group.
+type tracePoint struct {
+	kyber.Point
+	t *traceGroup
+}
+
+// point returns the underlying point of p, keeping nil for the base point.
+func point(p kyber.Point) kyber.Point {
+	if tp, ok := p.(*tracePoint); ok {
+		return tp.Point
+	}
+	return p
+}
+
+func (p *tracePoint) Equal(a kyber.Point) bool {
+	eq := p.Point.Equal(point(a))
+	p.t.log("Point", "Equal", eq, p, a)
+	return eq
+}
+
+func (p *tracePoint) Null() kyber.Point {
+	p.Point.Null()
+	p.t.log("Point", "Null", p)
+	return p
+}
+
+func (p *tracePoint) Base() kyber.Point {
+	p.Point.Base()
+	p.t.log("Point", "Base", p)
+	return p
+}
+
+func (p *tracePoint) Pick(rand cipher.Stream) kyber.Point {
+	p.Point.Pick(rand)
+	p.t.log("Point", "Pick", p)
+	return p
+}
+
+func (p *tracePoint) Set(a kyber.Point) kyber.Point {
+	p.Point.Set(point(a))
+	p.t.log("Point", "Set", p, a)
+	return p
+}
+
+func (p *tracePoint) Clone() kyber.Point {
+	return &tracePoint{p.Point.Clone(), p.t}
+}
+
+func (p *tracePoint) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	p.Point.Embed(data, rand)
+	p.t.log("Point", "Embed", p, data)
+	return p
+}
+
+func (p *tracePoint) Add(a, b kyber.Point) kyber.Point {
+	p.Point.Add(point(a), point(b))
+	p.t.log("Point", "Add", p, a, b)
+	return p
+}
+
+func (p *tracePoint) Sub(a, b kyber.Point) kyber.Point {
+	p.Point.Sub(point(a), point(b))
+	p.t.log("Point", "Sub", p, a, b)
+	return p
+}
+
+func (p *tracePoint) Neg(a kyber.Point) kyber.Point {
+	p.Point.Neg(point(a))
+	p.t.log("Point", "Neg", p, a)
+	return p
+}
+
+func (p *tracePoint) Mul(s kyber.Scalar, a kyber.Point) kyber.Point {
+	p.Point.Mul(scalar(s), point(a))
+	p.t.log("Point", "Mul", p, s, a)
+	return p
+}
+
+func (p *tracePoint) MulInt(k int64, a kyber.Point) kyber.Point {
+	p.Point.MulInt(k, point(a))
+	p.t.log("Point", "MulInt", p, k, a)
+	return p
+}
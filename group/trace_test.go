@@ -0,0 +1,56 @@
+package group
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	var log bytes.Buffer
+	g := Trace(suite, &log)
+
+	s := g.Scalar().SetInt64(5)
+	log.Reset()
+	P := g.Point().Mul(s, nil)
+
+	sb, _ := s.MarshalBinary()
+	pb, _ := suite.Point().Mul(suite.Scalar().SetInt64(5), nil).MarshalBinary()
+	require.Equal(t, "Point.Mul("+hex.EncodeToString(sb)+", base) = "+hex.EncodeToString(pb)+"\n", log.String())
+
+	// operations on traced values give the same results
+	Q := g.Point().Mul(s, P)
+	require.True(t, Q.Equal(suite.Point().Mul(suite.Scalar().SetInt64(25), nil)))
+	require.True(t, g.Point().Sub(Q, P).Equal(g.Point().Mul(g.Scalar().SetInt64(20), nil)))
+	require.True(t, g.Scalar().Inv(s).Mul(s, g.Scalar().Inv(s)).Equal(g.Scalar().One()))
+	one := traceHexOne(suite)
+	require.True(t, strings.HasSuffix(log.String(), "Scalar.Equal("+one+", "+one+") = true\n"))
+
+	// the traced suite still runs the protocols of the suite
+	ts, ok := g.(dleq.Suite)
+	require.True(t, ok)
+	x := ts.Scalar().Pick(random.Stream)
+	H := ts.Point().Pick(random.Stream)
+	log.Reset()
+	proof, xG, xH, err := dleq.NewDLEQProof(ts, nil, H, x)
+	require.Nil(t, err)
+	require.Nil(t, proof.Verify(ts, ts.Point().Base(), H, xG, xH))
+	require.True(t, strings.Contains(log.String(), "Point.Equal("))
+
+	// groups without hash are traced as plain groups
+	_, ok = Trace(struct{ kyber.Group }{suite}, &log).(dleq.Suite)
+	require.False(t, ok)
+}
+
+func traceHexOne(g kyber.Group) string {
+	b, _ := g.Scalar().One().MarshalBinary()
+	return hex.EncodeToString(b)
+}
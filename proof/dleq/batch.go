@@ -0,0 +1,178 @@
+package dleq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+var errorBatchFormat = errors.New("malformed batch of proofs")
+
+// Flags of the serialized batch telling which elements are shared.
+const (
+	sharedG byte = 1 << iota
+	sharedH
+	sharedC
+)
+
+// DLEQProofBatch bundles a batch of proofs, e.g. from NewDLEQProofBatch,
+// together with their base points G[i] and H[i], for storing them in a
+// transcript. Its binary encoding factors out the elements common to all the
+// proofs: the base points when they are all equal, like H in PVSS, and the
+// challenge, which NewDLEQProofBatch computes over the whole batch.
+type DLEQProofBatch struct {
+	G      []kyber.Point
+	H      []kyber.Point
+	Proofs []*Proof
+}
+
+// MarshalBinary encodes the batch as a flags byte telling which of G, H and
+// the challenges are shared, the 32-bit big-endian number of proofs n, then
+// the base points G and H and the challenges, each either once if shared or n
+// times otherwise, and finally the R, VG and VH of each proof.
+func (b *DLEQProofBatch) MarshalBinary() ([]byte, error) {
+	n := len(b.Proofs)
+	if len(b.G) != n || len(b.H) != n {
+		return nil, errorDifferentLengths
+	}
+	C := make([]kyber.Marshaling, n)
+	for i, p := range b.Proofs {
+		C[i] = p.C
+	}
+	var flags byte
+	G, shared := factor(points(b.G))
+	if shared {
+		flags |= sharedG
+	}
+	H, shared := factor(points(b.H))
+	if shared {
+		flags |= sharedH
+	}
+	C, shared = factor(C)
+	if shared {
+		flags |= sharedC
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(flags)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(n))
+	for _, elems := range [][]kyber.Marshaling{G, H, C} {
+		for _, m := range elems {
+			if _, err := m.MarshalTo(&buf); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, p := range b.Proofs {
+		for _, m := range []kyber.Marshaling{p.R, p.VG, p.VH} {
+			if _, err := m.MarshalTo(&buf); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalDLEQProofBatch decodes a batch of proofs encoded with
+// MarshalBinary. The proofs still have to be verified.
+func UnmarshalDLEQProofBatch(suite Suite, data []byte) (*DLEQProofBatch, error) {
+	r := bytes.NewReader(data)
+	flags, err := r.ReadByte()
+	if err != nil || flags&^(sharedG|sharedH|sharedC) != 0 {
+		return nil, errorBatchFormat
+	}
+	var count uint32
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return nil, errorBatchFormat
+	}
+	// each proof takes at least R, VG and VH: reject the count before
+	// allocating anything if the data is too short for it
+	if uint64(count)*uint64(suite.ScalarLen()+2*suite.PointLen()) > uint64(r.Len()) {
+		return nil, errorBatchFormat
+	}
+	n := int(count)
+
+	b := &DLEQProofBatch{
+		G:      make([]kyber.Point, n),
+		H:      make([]kyber.Point, n),
+		Proofs: make([]*Proof, n),
+	}
+	read := func(m kyber.Marshaling) error {
+		_, err := m.UnmarshalFrom(r)
+		return err
+	}
+	readPoints := func(dst []kyber.Point, shared bool) error {
+		for i := range dst {
+			if shared && i > 0 {
+				dst[i] = dst[0].Clone()
+				continue
+			}
+			dst[i] = suite.Point()
+			if err := read(dst[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := readPoints(b.G, flags&sharedG != 0); err != nil {
+		return nil, err
+	}
+	if err := readPoints(b.H, flags&sharedH != 0); err != nil {
+		return nil, err
+	}
+	for i := range b.Proofs {
+		p := &Proof{}
+		if flags&sharedC != 0 && i > 0 {
+			p.C = b.Proofs[0].C.Clone()
+		} else {
+			p.C = suite.Scalar()
+			if err := read(p.C); err != nil {
+				return nil, err
+			}
+		}
+		b.Proofs[i] = p
+	}
+	for _, p := range b.Proofs {
+		p.R = suite.Scalar()
+		p.VG = suite.Point()
+		p.VH = suite.Point()
+		for _, m := range []kyber.Marshaling{p.R, p.VG, p.VH} {
+			if err := read(m); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if r.Len() != 0 {
+		return nil, errorBatchFormat
+	}
+	return b, nil
+}
+
+func points(ps []kyber.Point) []kyber.Marshaling {
+	ms := make([]kyber.Marshaling, len(ps))
+	for i, p := range ps {
+		ms[i] = p
+	}
+	return ms
+}
+
+// factor returns the single element of elems if they are all equal, with
+// true, and elems itself otherwise. An empty list is never shared.
+func factor(elems []kyber.Marshaling) ([]kyber.Marshaling, bool) {
+	if len(elems) == 0 {
+		return elems, false
+	}
+	first, err := elems[0].MarshalBinary()
+	if err != nil {
+		return elems, false
+	}
+	for _, m := range elems[1:] {
+		buf, err := m.MarshalBinary()
+		if err != nil || !bytes.Equal(buf, first) {
+			return elems, false
+		}
+	}
+	return elems[:1], true
+}
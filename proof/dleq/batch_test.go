@@ -0,0 +1,78 @@
+package dleq
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDLEQProofBatchMarshal(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 10
+	// as in PVSS: a common base H and one public key per trustee
+	H := suite.Point().Pick(random.Stream)
+	G := make([]kyber.Point, n)
+	X := make([]kyber.Point, n)
+	x := make([]kyber.Scalar, n)
+	for i := 0; i < n; i++ {
+		G[i] = H
+		X[i] = suite.Point().Pick(random.Stream)
+		x[i] = suite.Scalar().Pick(random.Stream)
+	}
+	proofs, xG, xH, err := NewDLEQProofBatch(suite, G, X, x, nil)
+	require.Nil(t, err)
+
+	batch := &DLEQProofBatch{G, X, proofs}
+	buf, err := batch.MarshalBinary()
+	require.Nil(t, err)
+
+	// each proof on its own, with its base points
+	independent := 0
+	for i, p := range proofs {
+		for _, m := range []kyber.Marshaling{G[i], X[i], p.C, p.R, p.VG, p.VH} {
+			independent += m.MarshalSize()
+		}
+	}
+	require.True(t, len(buf) < independent, "batch %d bytes, independent %d bytes", len(buf), independent)
+	S, P := suite.ScalarLen(), suite.PointLen()
+	require.Equal(t, 5+P+n*P+S+n*(S+2*P), len(buf))
+
+	dec, err := UnmarshalDLEQProofBatch(suite, buf)
+	require.Nil(t, err)
+	require.Equal(t, n, len(dec.Proofs))
+	for i, p := range dec.Proofs {
+		require.True(t, dec.G[i].Equal(G[i]))
+		require.True(t, dec.H[i].Equal(X[i]))
+		require.True(t, p.C.Equal(proofs[i].C))
+		require.True(t, p.R.Equal(proofs[i].R))
+		require.Nil(t, p.Verify(suite, dec.G[i], dec.H[i], xG[i], xH[i]))
+	}
+	// shared elements are decoded into distinct objects
+	dec.G[0].Add(dec.G[0], suite.Point().Base())
+	require.True(t, dec.G[1].Equal(H))
+
+	// nothing shared between independent proofs
+	p0, _, _, err := NewDLEQProof(suite, G[0], X[0], x[0])
+	require.Nil(t, err)
+	p1, _, _, err := NewDLEQProof(suite, X[1], G[1], x[1])
+	require.Nil(t, err)
+	buf, err = (&DLEQProofBatch{[]kyber.Point{G[0], X[1]}, []kyber.Point{X[0], G[1]}, []*Proof{p0, p1}}).MarshalBinary()
+	require.Nil(t, err)
+	require.Equal(t, byte(0), buf[0])
+	dec, err = UnmarshalDLEQProofBatch(suite, buf)
+	require.Nil(t, err)
+	require.Nil(t, dec.Proofs[1].Verify(suite, dec.G[1], dec.H[1], suite.Point().Mul(x[1], X[1]), suite.Point().Mul(x[1], G[1])))
+
+	// malformed encodings
+	_, err = UnmarshalDLEQProofBatch(suite, buf[:len(buf)-1])
+	require.NotNil(t, err)
+	_, err = UnmarshalDLEQProofBatch(suite, append(buf, 0))
+	require.Equal(t, errorBatchFormat, err)
+	_, err = UnmarshalDLEQProofBatch(suite, []byte{0, 0xff, 0xff, 0xff, 0xff})
+	require.Equal(t, errorBatchFormat, err)
+	_, err = (&DLEQProofBatch{G[:1], X, proofs}).MarshalBinary()
+	require.Equal(t, errorDifferentLengths, err)
+}
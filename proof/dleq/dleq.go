@@ -6,10 +6,11 @@
 package dleq
 
 import (
+	"crypto/cipher"
 	"errors"
 
 	"github.com/dedis/kyber"
-	h "github.com/dedis/kyber/util/hash"
+	"github.com/dedis/kyber/util/hash"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -22,6 +23,14 @@ type Suite interface {
 
 var errorDifferentLengths = errors.New("inputs of different lengths")
 var errorInvalidProof = errors.New("invalid proof")
+var errorChallenge = errors.New("challenge of the proof does not match")
+
+// ChallengeFunc returns the challenge of a proof given its transcript, the
+// concatenation of the marshaled points xG, xH, vG and vH. It lets the
+// challenge come from an external source, such as a verifier in an
+// interactive protocol or a verifiable random beacon, instead of the
+// Fiat-Shamir hash. The prover and the verifier must use the same function.
+type ChallengeFunc func(transcript []byte) kyber.Scalar
 
 // Proof represents a NIZK dlog-equality proof.
 type Proof struct {
@@ -36,7 +45,19 @@ type Proof struct {
 // and then computes the challenge c = H(xG,xH,vG,vH) and response r = v - cx.
 // Besides the proof, this function also returns the encrypted base points xG
 // and xH.
+//
+// The challenge is group.HashToScalar of the marshaled points. Earlier
+// versions picked it with the suite's cipher keyed with the hash of the
+// concatenated points, so proofs made by either version fail the challenge
+// checks of the other, such as VerifySingle, while Verify accepts both.
 func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (proof *Proof, xG kyber.Point, xH kyber.Point, err error) {
+	return NewDLEQProofWithChallenge(suite, G, H, x, nil)
+}
+
+// NewDLEQProofWithChallenge provides the same functionality as NewDLEQProof
+// but takes the challenge from f. If f is nil, the challenge is the
+// Fiat-Shamir hash of NewDLEQProof.
+func NewDLEQProofWithChallenge(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar, f ChallengeFunc) (proof *Proof, xG kyber.Point, xH kyber.Point, err error) {
 	// Encrypt base points with secret
 	xG = suite.Point().Mul(x, G)
 	xH = suite.Point().Mul(x, H)
@@ -47,11 +68,10 @@ func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (pr
 	vH := suite.Point().Mul(v, H)
 
 	// Challenge
-	cb, err := h.Structures(suite.Hash(), xG, xH, vG, vH)
+	c, err := challengeWith(suite, f, xG, xH, vG, vH)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	c := suite.Scalar().Pick(suite.Cipher(cb))
 
 	// Response
 	r := suite.Scalar()
@@ -61,13 +81,28 @@ func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (pr
 }
 
 // NewDLEQProofBatch computes lists of NIZK dlog-equality proofs and of
-// encrypted base points xG and xH. Note that the challenge is computed over all
-// input values.
-func NewDLEQProofBatch(suite Suite, G []kyber.Point, H []kyber.Point, secrets []kyber.Scalar) (proof []*Proof, xG []kyber.Point, xH []kyber.Point, err error) {
+// encrypted base points xG and xH. The outputs are aligned with the inputs:
+// proof[i] is the proof for secrets[i] with respect to G[i] and H[i], and
+// xG[i] and xH[i] are secrets[i] times G[i] and H[i]. Note that the challenge
+// is computed over all input values. The commitments are drawn from rand, or
+// from random.Stream if rand is nil. Concurrent calls don't share any state
+// besides rand, so each goroutine can use its own stream to avoid contending
+// on random.Stream.
+//
+// The commitments are the nonces of the proofs: anyone holding two proofs of
+// the same secret made with the same commitment, or able to predict it,
+// recovers the secret. rand must thus be a cryptographically secure stream,
+// e.g. a suite's Cipher keyed with fresh random bytes, and a deterministic
+// stream must never be replayed, nor shared with data made public.
+func NewDLEQProofBatch(suite Suite, G []kyber.Point, H []kyber.Point, secrets []kyber.Scalar, rand cipher.Stream) (proof []*Proof, xG []kyber.Point, xH []kyber.Point, err error) {
 	if len(G) != len(H) || len(H) != len(secrets) {
 		return nil, nil, nil, errorDifferentLengths
 	}
 
+	if rand == nil {
+		rand = random.Stream
+	}
+
 	n := len(secrets)
 	proofs := make([]*Proof, n)
 	v := make([]kyber.Scalar, n)
@@ -82,17 +117,20 @@ func NewDLEQProofBatch(suite Suite, G []kyber.Point, H []kyber.Point, secrets []
 		xH[i] = suite.Point().Mul(x, H[i])
 
 		// Commitments
-		v[i] = suite.Scalar().Pick(random.Stream)
+		v[i] = suite.Scalar().Pick(rand)
 		vG[i] = suite.Point().Mul(v[i], G[i])
 		vH[i] = suite.Point().Mul(v[i], H[i])
 	}
 
 	// Collective challenge
-	cb, err := h.Structures(suite.Hash(), xG, xH, vG, vH)
+	points := make([]kyber.Point, 0, 4*n)
+	for _, ps := range [][]kyber.Point{xG, xH, vG, vH} {
+		points = append(points, ps...)
+	}
+	c, err := challenge(suite, points...)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	c := suite.Scalar().Pick(suite.Cipher(cb))
 
 	// Responses
 	for i, x := range secrets {
@@ -120,3 +158,55 @@ func (p *Proof) Verify(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point
 	}
 	return nil
 }
+
+// VerifySingle provides the same functionality as Verify but also checks that
+// the challenge of the proof is the Fiat-Shamir hash NewDLEQProof derives from
+// xG, xH and the commitments of the proof. Verify can't check it, since the
+// proofs of NewDLEQProofBatch share a challenge derived from all their inputs,
+// but without it anyone can forge a proof for any xH from a random challenge
+// and response: the proofs of NewDLEQProof must be verified with VerifySingle.
+func (p *Proof) VerifySingle(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point, xH kyber.Point) error {
+	return p.VerifyWithChallenge(suite, G, H, xG, xH, nil)
+}
+
+// VerifyWithChallenge provides the same functionality as Verify but also
+// checks that the challenge of the proof is the one f returns for its
+// transcript. If f is nil, the challenge must be the Fiat-Shamir hash of
+// NewDLEQProof.
+func (p *Proof) VerifyWithChallenge(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point, xH kyber.Point, f ChallengeFunc) error {
+	c, err := challengeWith(suite, f, xG, xH, p.VG, p.VH)
+	if err != nil {
+		return err
+	}
+	if !c.Equal(p.C) {
+		return errorChallenge
+	}
+	return p.Verify(suite, G, H, xG, xH)
+}
+
+// challengeWith returns the challenge f gives for the marshaled points, or
+// their Fiat-Shamir challenge if f is nil.
+func challengeWith(suite Suite, f ChallengeFunc, points ...kyber.Point) (kyber.Scalar, error) {
+	if f == nil {
+		return challenge(suite, points...)
+	}
+	var transcript []byte
+	for _, p := range points {
+		buf, err := p.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		transcript = append(transcript, buf...)
+	}
+	return f(transcript), nil
+}
+
+// challenge derives the challenge of a proof from its points with
+// hash.Scalar, each point being one input.
+func challenge(suite Suite, points ...kyber.Point) (kyber.Scalar, error) {
+	inputs := make([]hash.Framed, len(points))
+	for i, p := range points {
+		inputs[i] = p
+	}
+	return hash.Scalar(suite, inputs...)
+}
@@ -1,9 +1,11 @@
 package dleq
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group"
 	"github.com/dedis/kyber/group/edwards25519"
 	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/require"
@@ -23,6 +25,26 @@ func TestDLEQProof(t *testing.T) {
 	}
 }
 
+func TestDLEQProofForged(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	x := suite.Scalar().Pick(random.Stream)
+	g := suite.Point().Pick(random.Stream)
+	h := suite.Point().Pick(random.Stream)
+	proof, xG, xH, err := NewDLEQProof(suite, g, h, x)
+	require.Nil(t, err)
+	require.Nil(t, proof.VerifySingle(suite, g, h, xG, xH))
+
+	// a random challenge and response satisfy the equations for any xH
+	xH = suite.Point().Pick(random.Stream)
+	c := suite.Scalar().Pick(random.Stream)
+	r := suite.Scalar().Pick(random.Stream)
+	vG := suite.Point().Add(suite.Point().Mul(r, g), suite.Point().Mul(c, xG))
+	vH := suite.Point().Add(suite.Point().Mul(r, h), suite.Point().Mul(c, xH))
+	forged := &Proof{c, r, vG, vH}
+	require.Nil(t, forged.Verify(suite, g, h, xG, xH))
+	require.Equal(t, errorChallenge, forged.VerifySingle(suite, g, h, xG, xH))
+}
+
 func TestDLEQProofBatch(t *testing.T) {
 	suite := edwards25519.NewAES128SHA256Ed25519()
 	n := 10
@@ -34,13 +56,55 @@ func TestDLEQProofBatch(t *testing.T) {
 		g[i] = suite.Point().Pick(random.Stream)
 		h[i] = suite.Point().Pick(random.Stream)
 	}
-	proofs, xG, xH, err := NewDLEQProofBatch(suite, g, h, x)
+	proofs, xG, xH, err := NewDLEQProofBatch(suite, g, h, x, nil)
 	require.Equal(t, err, nil)
 	for i := range proofs {
 		require.Nil(t, proofs[i].Verify(suite, g[i], h[i], xG[i], xH[i]))
 	}
 }
 
+func TestDLEQProofBatchConcurrent(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	workers := 8
+	n := 5
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// each worker has its own stream for the commitments, which
+			// draws nothing else
+			rand := suite.Cipher(random.Bytes(32, random.Stream))
+			x := make([]kyber.Scalar, n)
+			g := make([]kyber.Point, n)
+			h := make([]kyber.Point, n)
+			for i := range x {
+				x[i] = suite.Scalar().Pick(random.Stream)
+				g[i] = suite.Point().Pick(random.Stream)
+				h[i] = suite.Point().Pick(random.Stream)
+			}
+			for k := 0; k < 4; k++ {
+				proofs, xG, xH, err := NewDLEQProofBatch(suite, g, h, x, rand)
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				for i := range proofs {
+					if err := proofs[i].Verify(suite, g[i], h[i], xG[i], xH[i]); err != nil {
+						errs[w] = err
+						return
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.Nil(t, err)
+	}
+}
+
 func TestDLEQLengths(t *testing.T) {
 	suite := edwards25519.NewAES128SHA256Ed25519()
 	n := 10
@@ -54,6 +118,118 @@ func TestDLEQLengths(t *testing.T) {
 	}
 	// Remove an element to make the test fail
 	x = append(x[:5], x[6:]...)
-	_, _, _, err := NewDLEQProofBatch(suite, g, h, x)
+	_, _, _, err := NewDLEQProofBatch(suite, g, h, x, nil)
 	require.Equal(t, err, errorDifferentLengths)
 }
+
+// transcript returns the reference challenge, the hash to a scalar of the
+// marshaled points, each being one input.
+func transcript(t *testing.T, suite Suite, points ...kyber.Point) kyber.Scalar {
+	var data [][]byte
+	for _, p := range points {
+		b, err := p.MarshalBinary()
+		require.Nil(t, err)
+		data = append(data, b)
+	}
+	return group.HashToScalar(suite, data...)
+}
+
+func TestDLEQChallenge(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	x := suite.Scalar().Pick(random.Stream)
+	g := suite.Point().Pick(random.Stream)
+	h := suite.Point().Pick(random.Stream)
+	proof, xG, xH, err := NewDLEQProof(suite, g, h, x)
+	require.Nil(t, err)
+	require.True(t, proof.C.Equal(transcript(t, suite, xG, xH, proof.VG, proof.VH)))
+
+	n := 5
+	xs := make([]kyber.Scalar, n)
+	gs := make([]kyber.Point, n)
+	hs := make([]kyber.Point, n)
+	for i := 0; i < n; i++ {
+		xs[i] = suite.Scalar().Pick(random.Stream)
+		gs[i] = suite.Point().Pick(random.Stream)
+		hs[i] = suite.Point().Pick(random.Stream)
+	}
+	proofs, xGs, xHs, err := NewDLEQProofBatch(suite, gs, hs, xs, random.Stream)
+	require.Nil(t, err)
+	var points []kyber.Point
+	points = append(points, xGs...)
+	points = append(points, xHs...)
+	for _, p := range proofs {
+		points = append(points, p.VG)
+	}
+	for _, p := range proofs {
+		points = append(points, p.VH)
+	}
+	c := transcript(t, suite, points...)
+	for _, p := range proofs {
+		require.True(t, p.C.Equal(c))
+	}
+}
+
+func TestDLEQProofWithChallenge(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	x := suite.Scalar().Pick(random.Stream)
+	g := suite.Point().Pick(random.Stream)
+	h := suite.Point().Pick(random.Stream)
+
+	// a beacon binding its output to the transcript it is asked for
+	beacon := func(round string) ChallengeFunc {
+		return func(transcript []byte) kyber.Scalar {
+			require.Equal(t, 4*suite.PointLen(), len(transcript))
+			return group.HashToScalar(suite, []byte(round), transcript)
+		}
+	}
+	proof, xG, xH, err := NewDLEQProofWithChallenge(suite, g, h, x, beacon("round 1"))
+	require.Nil(t, err)
+	require.Nil(t, proof.Verify(suite, g, h, xG, xH))
+	require.Nil(t, proof.VerifyWithChallenge(suite, g, h, xG, xH, beacon("round 1")))
+	require.Equal(t, errorChallenge, proof.VerifyWithChallenge(suite, g, h, xG, xH, beacon("round 2")))
+	require.Equal(t, errorChallenge, proof.VerifyWithChallenge(suite, g, h, xG, xH, nil))
+
+	// the default is the Fiat-Shamir challenge
+	proof, xG, xH, err = NewDLEQProofWithChallenge(suite, g, h, x, nil)
+	require.Nil(t, err)
+	require.True(t, proof.C.Equal(transcript(t, suite, xG, xH, proof.VG, proof.VH)))
+	require.Nil(t, proof.VerifyWithChallenge(suite, g, h, xG, xH, nil))
+	proof, xG, xH, err = NewDLEQProof(suite, g, h, x)
+	require.Nil(t, err)
+	require.Nil(t, proof.VerifyWithChallenge(suite, g, h, xG, xH, nil))
+
+	// a proof with a valid challenge but a wrong response still fails
+	proof, xG, xH, err = NewDLEQProofWithChallenge(suite, g, h, x, beacon("round 1"))
+	require.Nil(t, err)
+	proof.R.Add(proof.R, suite.Scalar().One())
+	require.Equal(t, errorInvalidProof, proof.VerifyWithChallenge(suite, g, h, xG, xH, beacon("round 1")))
+}
+
+func TestDLEQProofBatchOrder(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 6
+	x := make([]kyber.Scalar, n)
+	g := make([]kyber.Point, n)
+	h := make([]kyber.Point, n)
+	for i := range x {
+		x[i] = suite.Scalar().Pick(random.Stream)
+		g[i] = suite.Point().Pick(random.Stream)
+		h[i] = suite.Point().Pick(random.Stream)
+	}
+	proofs, xG, xH, err := NewDLEQProofBatch(suite, g, h, x, nil)
+	require.Nil(t, err)
+	require.Equal(t, n, len(proofs))
+	for i := range proofs {
+		require.True(t, xG[i].Equal(suite.Point().Mul(x[i], g[i])))
+		require.True(t, xH[i].Equal(suite.Point().Mul(x[i], h[i])))
+		for j := range proofs {
+			// each proof only holds for the inputs of its own index
+			err := proofs[i].Verify(suite, g[j], h[j], xG[j], xH[j])
+			if i == j {
+				require.Nil(t, err)
+			} else {
+				require.Equal(t, errorInvalidProof, err)
+			}
+		}
+	}
+}
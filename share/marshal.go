@@ -0,0 +1,80 @@
+package share
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+var errorShareIndex = errors.New("share index out of range")
+var errorShareLength = errors.New("wrong share encoding length")
+
+// MarshalPriShare encodes the private share s of the group g as its index, a
+// 32-bit big-endian integer, followed by the binary encoding of its value.
+// These are functions rather than methods of the shares so that reflection
+// based encoders, such as the protobuf encoding of the VSS deals, keep
+// encoding the shares field by field.
+func MarshalPriShare(g kyber.Group, s *PriShare) ([]byte, error) {
+	return marshalShare(s.I, s.V, g.ScalarLen())
+}
+
+// UnmarshalPriShare decodes a private share of the group g encoded with
+// MarshalPriShare.
+func UnmarshalPriShare(g kyber.Group, data []byte) (*PriShare, error) {
+	s := &PriShare{V: g.Scalar()}
+	i, err := unmarshalShare(data, s.V)
+	if err != nil {
+		return nil, err
+	}
+	s.I = i
+	return s, nil
+}
+
+// MarshalPubShare encodes the public share s of the group g as its index, a
+// 32-bit big-endian integer, followed by the binary encoding of its value.
+func MarshalPubShare(g kyber.Group, s *PubShare) ([]byte, error) {
+	return marshalShare(s.I, s.V, g.PointLen())
+}
+
+// UnmarshalPubShare decodes a public share of the group g encoded with
+// MarshalPubShare.
+func UnmarshalPubShare(g kyber.Group, data []byte) (*PubShare, error) {
+	s := &PubShare{V: g.Point()}
+	i, err := unmarshalShare(data, s.V)
+	if err != nil {
+		return nil, err
+	}
+	s.I = i
+	return s, nil
+}
+
+func marshalShare(i int, v kyber.Marshaling, size int) ([]byte, error) {
+	if i < 0 || int64(i) > 0xffffffff {
+		return nil, errorShareIndex
+	}
+	buf, err := v.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) != size {
+		return nil, errorShareLength
+	}
+	data := make([]byte, 4, 4+len(buf))
+	binary.BigEndian.PutUint32(data, uint32(i))
+	return append(data, buf...), nil
+}
+
+func unmarshalShare(data []byte, v kyber.Marshaling) (int, error) {
+	if len(data) != 4+v.MarshalSize() {
+		return 0, errorShareLength
+	}
+	i := binary.BigEndian.Uint32(data)
+	if uint64(i) > uint64(^uint(0)>>1) {
+		return 0, errorShareIndex
+	}
+	if err := v.UnmarshalBinary(data[4:]); err != nil {
+		return 0, err
+	}
+	return int(i), nil
+}
@@ -0,0 +1,45 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriShareMarshal(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	s := &PriShare{I: 7, V: g.Scalar().Pick(random.Stream)}
+	buf, err := MarshalPriShare(g, s)
+	require.Nil(test, err)
+	require.Equal(test, 4+g.ScalarLen(), len(buf))
+
+	dec, err := UnmarshalPriShare(g, buf)
+	require.Nil(test, err)
+	require.Equal(test, s.I, dec.I)
+	require.True(test, s.V.Equal(dec.V))
+
+	_, err = UnmarshalPriShare(g, buf[1:])
+	require.Equal(test, errorShareLength, err)
+	_, err = MarshalPriShare(g, &PriShare{I: -1, V: s.V})
+	require.Equal(test, errorShareIndex, err)
+}
+
+func TestPubShareMarshal(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	s := &PubShare{I: 3, V: g.Point().Pick(random.Stream)}
+	buf, err := MarshalPubShare(g, s)
+	require.Nil(test, err)
+	require.Equal(test, 4+g.PointLen(), len(buf))
+
+	dec, err := UnmarshalPubShare(g, buf)
+	require.Nil(test, err)
+	require.Equal(test, s.I, dec.I)
+	require.True(test, s.V.Equal(dec.V))
+
+	_, err = UnmarshalPubShare(g, append(buf, 0))
+	require.Equal(test, errorShareLength, err)
+	_, err = MarshalPubShare(g, &PubShare{I: -1, V: s.V})
+	require.Equal(test, errorShareIndex, err)
+}
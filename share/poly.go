@@ -28,6 +28,8 @@ type Suite interface {
 // Some error definitions
 var errorGroups = errors.New("non-matching groups")
 var errorCoeffs = errors.New("different number of coefficients")
+var errorNoPolys = errors.New("no polynomial to sum")
+var errorCoeffIndex = errors.New("coefficient index out of range")
 
 // PriShare represents a private share.
 type PriShare struct {
@@ -73,13 +75,22 @@ func (p *PriPoly) Secret() kyber.Scalar {
 	return p.coeffs[0]
 }
 
+// Coefficients returns a copy of the coefficients of the polynomial, constant
+// term first.
+func (p *PriPoly) Coefficients() []kyber.Scalar {
+	coeffs := make([]kyber.Scalar, len(p.coeffs))
+	for i, c := range p.coeffs {
+		coeffs[i] = c.Clone()
+	}
+	return coeffs
+}
+
 // Eval computes the private share v = p(i).
 func (p *PriPoly) Eval(i int) *PriShare {
 	xi := p.g.Scalar().SetInt64(1 + int64(i))
 	v := p.g.Scalar().Zero()
 	for j := p.Threshold() - 1; j >= 0; j-- {
-		v.Mul(v, xi)
-		v.Add(v, p.coeffs[j])
+		v.MulAdd(v, xi, p.coeffs[j])
 	}
 	return &PriShare{i, v}
 }
@@ -93,6 +104,42 @@ func (p *PriPoly) Shares(n int) []*PriShare {
 	return shares
 }
 
+// Precompute returns the public commitment shares p(1),...,p(n), like Shares,
+// for callers that verify many shares against the same polynomial over time,
+// e.g. by passing the values as sH to pvss.VerifyEncShareBatch. Past the first
+// t evaluations, it steps through the forward differences of the polynomial
+// and thus only needs point additions instead of a full Horner evaluation per
+// index.
+func (p *PubPoly) Precompute(n int) []*PubShare {
+	t := p.Threshold()
+	shares := make([]*PubShare, n)
+	for i := 0; i < n && i < t; i++ {
+		shares[i] = p.Eval(i)
+	}
+	if n <= t {
+		return shares
+	}
+	// d[k] holds the k-th forward difference at the current index
+	d := make([]kyber.Point, t)
+	for k := range d {
+		d[k] = shares[k].V.Clone()
+	}
+	for k := 1; k < t; k++ {
+		for j := t - 1; j >= k; j-- {
+			d[j].Sub(d[j], d[j-1])
+		}
+	}
+	for i := 1; i < n; i++ {
+		for k := 0; k < t-1; k++ {
+			d[k].Add(d[k], d[k+1])
+		}
+		if i >= t {
+			shares[i] = &PubShare{i, d[0].Clone()}
+		}
+	}
+	return shares
+}
+
 // Add computes the component-wise sum of the polynomials p and q and returns it
 // as a new polynomial.
 func (p *PriPoly) Add(q *PriPoly) (*PriPoly, error) {
@@ -310,6 +357,14 @@ func (p *PubPoly) Commit() kyber.Point {
 	return p.commits[0]
 }
 
+// IsFullDegree returns whether p is a commitment to a polynomial of degree
+// exactly t-1: it must have t coefficients, the leading one not committing to
+// zero. A dealer sharing with a lower degree polynomial lets fewer than t
+// shares recover the secret.
+func (p *PubPoly) IsFullDegree(t int) bool {
+	return t > 0 && p.Threshold() == t && !p.commits[t-1].Equal(p.g.Point().Null())
+}
+
 // Eval computes the public share v = p(i).
 func (p *PubPoly) Eval(i int) *PubShare {
 	xi := p.g.Scalar().SetInt64(1 + int64(i)) // x-coordinate of this share
@@ -353,6 +408,49 @@ func (p *PubPoly) Add(q *PubPoly) (*PubPoly, error) {
 	return &PubPoly{p.g, p.b, commits}, nil
 }
 
+// UpdateCoeff adjusts the commitment polynomial p in place after delta was
+// added to the i-th coefficient of the secret polynomial, by adding delta*H to
+// the i-th commitment. H must be the base point p was committed with, as
+// returned by Info; if it is nil, the base point of p is used. The other
+// commitments are left untouched, which saves recomputing the whole
+// commitment when a dealer corrects a single coefficient.
+func (p *PubPoly) UpdateCoeff(i int, delta kyber.Scalar, H kyber.Point) error {
+	if i < 0 || i >= p.Threshold() {
+		return errorCoeffIndex
+	}
+	if H == nil {
+		H = p.b
+	}
+	p.commits[i] = p.g.Point().Add(p.commits[i], p.g.Point().Mul(delta, H))
+	return nil
+}
+
+// SumCommitments returns the sum of the public commitment polynomials, which
+// must all have the same group and threshold. In a Pedersen DKG, summing the
+// polynomials of all the dealers yields the commitment to the distributed
+// secret: its constant term is the group public key. As for Add, the base
+// point of the result is the one of the first polynomial.
+func SumCommitments(polys []*PubPoly) (*PubPoly, error) {
+	if len(polys) == 0 {
+		return nil, errorNoPolys
+	}
+	sum := polys[0]
+	for _, p := range polys[1:] {
+		var err error
+		if sum, err = sum.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	if len(polys) == 1 {
+		commits := make([]kyber.Point, sum.Threshold())
+		for i, c := range sum.commits {
+			commits[i] = c.Clone()
+		}
+		sum = &PubPoly{sum.g, sum.b, commits}
+	}
+	return sum, nil
+}
+
 // Equal checks equality of two public commitment polynomials p and q.
 func (p *PubPoly) Equal(q *PubPoly) bool {
 	if p.g.String() != q.g.String() {
@@ -367,6 +465,21 @@ func (p *PubPoly) Equal(q *PubPoly) bool {
 	return b == 1
 }
 
+// Hash returns the hash representation of this public commitment polynomial,
+// binding both its base point and its commitments.
+func (p *PubPoly) Hash(s Suite) []byte {
+	h := s.Hash()
+	b := p.b
+	if b == nil {
+		b = p.g.Point().Base()
+	}
+	_, _ = b.MarshalTo(h)
+	for _, c := range p.commits {
+		_, _ = c.MarshalTo(h)
+	}
+	return h.Sum(nil)
+}
+
 // Check a private share against a public commitment polynomial.
 func (p *PubPoly) Check(s *PriShare) bool {
 	pv := p.Eval(s.I)
@@ -377,6 +490,15 @@ func (p *PubPoly) Check(s *PriShare) bool {
 // RecoverCommit reconstructs the secret commitment p(0) from a list of public
 // shares using Lagrange interpolation.
 func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, error) {
+	return RecoverCommitAt(g, shares, 0, t, n)
+}
+
+// RecoverCommitAt generalizes RecoverCommit to interpolate the commitment
+// polynomial at any target x-coordinate, returning p(target). Recall that the
+// share of index i is the evaluation at x = i+1, so that target = i+1 yields
+// the commitment of the share of index i, and target = 0 the secret
+// commitment.
+func RecoverCommitAt(g kyber.Group, shares []*PubShare, target int, t, n int) (kyber.Point, error) {
 	x := make(map[int]kyber.Scalar)
 	for i, s := range shares {
 		if s == nil || s.V == nil || s.I < 0 || n <= s.I {
@@ -389,6 +511,7 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 		return nil, errors.New("not enough good public shares to reconstruct secret commitment")
 	}
 
+	xt := g.Scalar().SetInt64(int64(target))
 	num := g.Scalar()
 	den := g.Scalar()
 	tmp := g.Scalar()
@@ -402,8 +525,8 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 			if i == j {
 				continue
 			}
-			num.Mul(num, xj)
-			den.Mul(den, tmp.Sub(xj, xi))
+			num.Mul(num, tmp.Sub(xt, xj))
+			den.Mul(den, tmp.Sub(xi, xj))
 		}
 		Tmp.Mul(num.Div(num, den), shares[i].V)
 		Acc.Add(Acc, Tmp)
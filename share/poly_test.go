@@ -89,6 +89,21 @@ func TestSecretPolyEqual(test *testing.T) {
 	}
 }
 
+func TestPriPolyCoefficients(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	p := NewPriPoly(g, 3, nil, random.Stream)
+	secret := p.Secret().Clone()
+
+	coeffs := p.Coefficients()
+	assert.Equal(test, 3, len(coeffs))
+	assert.True(test, secret.Equal(coeffs[0]))
+
+	coeffs[0].Zero()
+	coeffs[1] = g.Scalar().Zero()
+	assert.True(test, secret.Equal(p.Secret()))
+	assert.False(test, p.coeffs[1].Equal(coeffs[1]))
+}
+
 func TestPublicCheck(test *testing.T) {
 	g := edwards25519.NewAES128SHA256Ed25519()
 	n := 10
@@ -303,3 +318,130 @@ func TestRecoverPriPoly(test *testing.T) {
 		assert.Equal(test, reverseRecovered.Eval(i).V.String(), a.Eval(i).V.String())
 	}
 }
+
+func TestPubPolyHash(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	n := 10
+	t := n/2 + 1
+	priPoly := NewPriPoly(g, t, nil, g.Cipher([]byte("hash")))
+	pubPoly := priPoly.Commit(nil)
+	assert.Equal(test, pubPoly.Hash(g), priPoly.Commit(g.Point().Base()).Hash(g))
+
+	other := NewPriPoly(g, t, nil, g.Cipher([]byte("other"))).Commit(nil)
+	assert.NotEqual(test, pubPoly.Hash(g), other.Hash(g))
+}
+
+func TestPubPolyPrecompute(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	for _, t := range []int{1, 2, 5} {
+		pubPoly := NewPriPoly(g, t, nil, g.Cipher([]byte("precompute"))).Commit(nil)
+		for _, n := range []int{0, 3, 20} {
+			shares := pubPoly.Precompute(n)
+			assert.Equal(test, n, len(shares))
+			for i, s := range shares {
+				assert.Equal(test, i, s.I)
+				assert.True(test, pubPoly.Eval(i).V.Equal(s.V))
+			}
+		}
+	}
+}
+
+func TestRecoverCommitAt(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	n := 10
+	t := n/2 + 1
+	priPoly := NewPriPoly(g, t, nil, random.Stream)
+	pubPoly := priPoly.Commit(nil)
+	shares := pubPoly.Shares(n)
+
+	// at x = 0, the same as RecoverCommit
+	recovered, err := RecoverCommitAt(g, shares[n-t:], 0, t, n)
+	assert.Nil(test, err)
+	assert.True(test, recovered.Equal(pubPoly.Commit()))
+	commit, err := RecoverCommit(g, shares[n-t:], t, n)
+	assert.Nil(test, err)
+	assert.True(test, recovered.Equal(commit))
+
+	// the commitment of a missing share from the first t ones
+	for i := 0; i < n; i++ {
+		recovered, err := RecoverCommitAt(g, shares[:t], i+1, t, n)
+		assert.Nil(test, err)
+		assert.True(test, recovered.Equal(shares[i].V))
+	}
+
+	// beyond the share indices
+	recovered, err = RecoverCommitAt(g, shares[1:t+1], 42, t, n)
+	assert.Nil(test, err)
+	assert.True(test, recovered.Equal(g.Point().Mul(priPoly.Eval(41).V, nil)))
+
+	_, err = RecoverCommitAt(g, shares[:t-1], 0, t, n)
+	assert.NotNil(test, err)
+}
+
+func TestSumCommitments(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	t := 4
+	priPolys := make([]*PriPoly, 3)
+	pubPolys := make([]*PubPoly, 3)
+	for i := range priPolys {
+		priPolys[i] = NewPriPoly(g, t, nil, random.Stream)
+		pubPolys[i] = priPolys[i].Commit(nil)
+	}
+	sum, err := SumCommitments(pubPolys)
+	assert.Nil(test, err)
+
+	priSum, err := priPolys[0].Add(priPolys[1])
+	assert.Nil(test, err)
+	priSum, err = priSum.Add(priPolys[2])
+	assert.Nil(test, err)
+	assert.True(test, sum.Equal(priSum.Commit(nil)))
+	assert.True(test, sum.Commit().Equal(g.Point().Mul(priSum.Secret(), nil)))
+
+	// a single polynomial is copied
+	one, err := SumCommitments(pubPolys[:1])
+	assert.Nil(test, err)
+	assert.True(test, one.Equal(pubPolys[0]))
+	one.Commit().Add(one.Commit(), g.Point().Base())
+	assert.False(test, one.Equal(pubPolys[0]))
+
+	_, err = SumCommitments(nil)
+	assert.Equal(test, errorNoPolys, err)
+	_, err = SumCommitments(append(pubPolys, NewPriPoly(g, t+1, nil, random.Stream).Commit(nil)))
+	assert.Equal(test, errorCoeffs, err)
+}
+
+func TestPubPolyUpdateCoeff(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	t := 4
+	H := g.Point().Pick(random.Stream)
+	priPoly := NewPriPoly(g, t, nil, random.Stream)
+	pubPoly := priPoly.Commit(H)
+	before := priPoly.Commit(H)
+
+	delta := g.Scalar().Pick(random.Stream)
+	assert.Nil(test, pubPoly.UpdateCoeff(2, delta, H))
+	coeffs := priPoly.coeffs
+	coeffs[2] = g.Scalar().Add(coeffs[2], delta)
+	assert.True(test, pubPoly.Equal(priPoly.Commit(H)))
+	assert.False(test, pubPoly.Equal(before))
+
+	// a nil H stands for the base point of the polynomial
+	assert.Nil(test, pubPoly.UpdateCoeff(0, delta, nil))
+	coeffs[0] = g.Scalar().Add(coeffs[0], delta)
+	assert.True(test, pubPoly.Equal(priPoly.Commit(H)))
+
+	assert.Equal(test, errorCoeffIndex, pubPoly.UpdateCoeff(t, delta, H))
+	assert.Equal(test, errorCoeffIndex, pubPoly.UpdateCoeff(-1, delta, H))
+}
+
+func TestPubPolyIsFullDegree(test *testing.T) {
+	g := edwards25519.NewAES128SHA256Ed25519()
+	t := 4
+	priPoly := NewPriPoly(g, t, nil, random.Stream)
+	assert.True(test, priPoly.Commit(nil).IsFullDegree(t))
+	assert.False(test, priPoly.Commit(nil).IsFullDegree(t+1))
+	assert.False(test, priPoly.Commit(nil).IsFullDegree(t-1))
+
+	priPoly.coeffs[t-1] = g.Scalar().Zero()
+	assert.False(test, priPoly.Commit(nil).IsFullDegree(t))
+}
@@ -0,0 +1,41 @@
+package pvss
+
+import (
+	"bytes"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+// EncryptToCommittee encrypts the plaintext so that any t of the trustees
+// with public keys X can decrypt it together: it shares a fresh random secret
+// s among them with EncShares and encrypts the plaintext with EncryptStream
+// under the key derived from sG, G being the standard base point. It returns
+// the transcript of the distribution, which the trustees verify with
+// VerifyEncShare or an auditor with VerifyTranscript, and the ciphertext.
+// Once t trustees have decrypted their shares with DecShare, RecoverSecret
+// yields sG, and DecryptFromCommittee the plaintext.
+func EncryptToCommittee(suite Suite, H kyber.Point, X []kyber.Point, t int, plaintext []byte) (*Transcript, []byte, error) {
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ciphertext bytes.Buffer
+	key := suite.Point().Mul(secret, nil)
+	if err := EncryptStream(suite, key, bytes.NewReader(plaintext), &ciphertext); err != nil {
+		return nil, nil, err
+	}
+	transcript := &Transcript{H: H, X: X, EncShares: encShares, PubPoly: pubPoly, Hash: HashID(suite)}
+	return transcript, ciphertext.Bytes(), nil
+}
+
+// DecryptFromCommittee decrypts a ciphertext of EncryptToCommittee with the
+// secret recovered by the trustees with RecoverSecret.
+func DecryptFromCommittee(suite Suite, secret kyber.Point, ciphertext []byte) ([]byte, error) {
+	var plaintext bytes.Buffer
+	if err := DecryptStream(suite, secret, bytes.NewReader(ciphertext), &plaintext); err != nil {
+		return nil, err
+	}
+	return plaintext.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSEncryptToCommittee(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	n := 10
+	t := 2*n/3 + 1
+	x, X := newCommittee(suite, n)
+	H := BaseForCommittee(suite, X)
+	msg := []byte("to be opened by a quorum of the committee")
+
+	transcript, ciphertext, err := EncryptToCommittee(suite, H, X, t, msg)
+	require.Nil(test, err)
+	ok, _, err := VerifyTranscript(suite, transcript)
+	require.Nil(test, err)
+	require.True(test, ok)
+
+	// t trustees decrypt their shares
+	var K []kyber.Point
+	var E, D []*PubVerShare
+	for i := 0; i < t; i++ {
+		encShare := transcript.EncShares[i]
+		sH := transcript.PubPoly.Eval(encShare.S.I).V
+		ds, err := DecShare(suite, H, X[i], sH, x[i], encShare)
+		require.Nil(test, err)
+		K = append(K, X[i])
+		E = append(E, encShare)
+		D = append(D, ds)
+	}
+
+	_, err = RecoverSecret(suite, G, K[:t-1], E[:t-1], D[:t-1], t, n)
+	require.Equal(test, errorTooFewShares, err)
+
+	secret, err := RecoverSecret(suite, G, K, E, D, t, n)
+	require.Nil(test, err)
+	plaintext, err := DecryptFromCommittee(suite, secret, ciphertext)
+	require.Nil(test, err)
+	require.Equal(test, msg, plaintext)
+
+	_, err = DecryptFromCommittee(suite, suite.Point().Pick(random.Stream), ciphertext)
+	require.Error(test, err)
+}
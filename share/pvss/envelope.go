@@ -0,0 +1,115 @@
+package pvss
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+)
+
+var errorEnvelopeTrailing = errors.New("trailing data after envelope")
+
+// Envelope bundles everything a trustee needs to verify its encrypted share:
+// the base point H, the trustee's public key X, the encrypted share and the
+// commitment sH to its share. Data holds the serialized envelope, ready to be
+// sent to the trustee, who decodes it with ReadEnvelope.
+type Envelope struct {
+	H        kyber.Point
+	X        kyber.Point
+	SH       kyber.Point
+	EncShare *PubVerShare
+	Data     []byte
+}
+
+// Envelopes splits the output of EncShares into one envelope per trustee, in
+// the order of X. The serialized form is H, X, sH, the 32-bit big-endian share
+// index, the encrypted share, and its proof (C, R, VG, VH), each element in
+// its binary encoding.
+func Envelopes(H kyber.Point, X []kyber.Point, encShares []*PubVerShare, pubPoly *share.PubPoly) ([]*Envelope, error) {
+	if len(X) != len(encShares) {
+		return nil, errorDifferentLengths
+	}
+	envelopes := make([]*Envelope, len(X))
+	for i := range X {
+		e := &Envelope{
+			H:        H,
+			X:        X[i],
+			SH:       pubPoly.Eval(encShares[i].S.I).V,
+			EncShare: encShares[i],
+		}
+		var err error
+		if e.Data, err = e.marshal(); err != nil {
+			return nil, err
+		}
+		envelopes[i] = e
+	}
+	return envelopes, nil
+}
+
+// ReadEnvelope decodes an envelope serialized by Envelopes. The encrypted
+// share still has to be checked, e.g. with VerifyEncShare.
+func ReadEnvelope(suite Suite, data []byte) (*Envelope, error) {
+	r := bytes.NewReader(data)
+	e := &Envelope{
+		H:        suite.Point(),
+		X:        suite.Point(),
+		SH:       suite.Point(),
+		EncShare: &PubVerShare{},
+		Data:     data,
+	}
+	s := e.EncShare
+	s.S.V = suite.Point()
+	s.P.C = suite.Scalar()
+	s.P.R = suite.Scalar()
+	s.P.VG = suite.Point()
+	s.P.VH = suite.Point()
+	var index uint32
+	for _, m := range []kyber.Marshaling{e.H, e.X, e.SH} {
+		if _, err := m.UnmarshalFrom(r); err != nil {
+			return nil, err
+		}
+	}
+	if err := binary.Read(r, binary.BigEndian, &index); err != nil {
+		return nil, err
+	}
+	s.S.I = int(index)
+	for _, m := range []kyber.Marshaling{s.S.V, s.P.C, s.P.R, s.P.VG, s.P.VH} {
+		if _, err := m.UnmarshalFrom(r); err != nil {
+			return nil, err
+		}
+	}
+	if r.Len() != 0 {
+		return nil, errorEnvelopeTrailing
+	}
+	return e, nil
+}
+
+func (e *Envelope) marshal() ([]byte, error) {
+	var b bytes.Buffer
+	for _, m := range []kyber.Marshaling{e.H, e.X, e.SH} {
+		if _, err := m.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	if err := writePubVerShare(&b, e.EncShare); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// writePubVerShare writes the index of the share as a 32-bit big-endian
+// integer, followed by the share and its proof.
+func writePubVerShare(w io.Writer, s *PubVerShare) error {
+	if err := binary.Write(w, binary.BigEndian, uint32(s.S.I)); err != nil {
+		return err
+	}
+	for _, m := range []kyber.Marshaling{s.S.V, s.P.C, s.P.R, s.P.VG, s.P.VH} {
+		if _, err := m.MarshalTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSEnvelopes(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	t := 3
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Nil(test, err)
+
+	envelopes, err := Envelopes(H, X, encShares, pubPoly)
+	require.Nil(test, err)
+	require.Equal(test, n, len(envelopes))
+	for i, e := range envelopes {
+		require.True(test, X[i].Equal(e.X))
+		require.Nil(test, VerifyEncShare(suite, e.H, e.X, e.SH, e.EncShare))
+
+		// the trustee only sees the serialized envelope
+		r, err := ReadEnvelope(suite, e.Data)
+		require.Nil(test, err)
+		require.Equal(test, encShares[i].S.I, r.EncShare.S.I)
+		require.Nil(test, VerifyEncShare(suite, r.H, r.X, r.SH, r.EncShare))
+	}
+
+	_, err = ReadEnvelope(suite, append(envelopes[0].Data, 0))
+	require.Equal(test, errorEnvelopeTrailing, err)
+	_, err = ReadEnvelope(suite, envelopes[0].Data[:10])
+	require.Error(test, err)
+	_, err = Envelopes(H, X[1:], encShares, pubPoly)
+	require.Equal(test, errorDifferentLengths, err)
+}
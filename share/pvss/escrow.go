@@ -0,0 +1,72 @@
+package pvss
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/key"
+)
+
+var errorEscrowSecret = errors.New("escrow needs the secret of the key pair")
+var errorEscrowSuite = errors.New("suite of the key pair cannot be used with PVSS")
+
+// Escrow is the public record of a key pair escrowed with EscrowKeyPair. It
+// can be published: the secret can only be recovered by t of the trustees.
+type Escrow struct {
+	Public     kyber.Point // Public key of the escrowed pair
+	Transcript *Transcript // PVSS distribution among the trustees
+	Ciphertext []byte      // Secret key encrypted to the committee
+}
+
+// EscrowKeyPair escrows the secret of the key pair with the trustees of
+// public keys trustees, so that any t of them can recover it, e.g. for the
+// social recovery of a lost key. As PVSS only lets the trustees recover a
+// point, the secret is encrypted with EncryptToCommittee under a key shared
+// among them with base point H. The trustees check their shares against the
+// transcript of the escrow with VerifyEncShare, and release them with
+// DecShare. The suite of the key pair must be a Suite.
+func EscrowKeyPair(kp *key.Pair, H kyber.Point, trustees []kyber.Point, t int) (*Escrow, error) {
+	if kp.Secret == nil {
+		return nil, errorEscrowSecret
+	}
+	suite, ok := kp.Suite.(Suite)
+	if !ok {
+		return nil, errorEscrowSuite
+	}
+	secret, err := kp.Secret.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	transcript, ciphertext, err := EncryptToCommittee(suite, H, trustees, t, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &Escrow{Public: kp.Public, Transcript: transcript, Ciphertext: ciphertext}, nil
+}
+
+// RecoverEscrowed recovers the key pair of the escrow from the decrypted
+// shares of the trustees, aligned with their public keys in the transcript as
+// for RecoverSecret; the invalid shares are skipped, and at least t must be
+// valid. It returns an error if the recovered secret does not match the
+// public key of the escrow. The Ed25519 seed of the original pair is not
+// escrowed, so key.ExportEd25519Seed fails on the recovered pair.
+func RecoverEscrowed(suite Suite, e *Escrow, decShares []*PubVerShare, t int) (*key.Pair, error) {
+	X := e.Transcript.X
+	secretKey, err := RecoverSecret(suite, suite.Point().Base(), X, e.Transcript.EncShares, decShares, t, len(X))
+	if err != nil {
+		return nil, err
+	}
+	buf, err := DecryptFromCommittee(suite, secretKey, e.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	secret := suite.Scalar()
+	if err := secret.UnmarshalBinary(buf); err != nil {
+		return nil, err
+	}
+	kp := &key.Pair{Suite: suite, Public: e.Public, Secret: secret}
+	if err := kp.Validate(); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
@@ -0,0 +1,60 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrowKeyPair(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n, t := 5, 3
+	trustees := make([]*key.Pair, n)
+	X := make([]kyber.Point, n)
+	for i := range trustees {
+		trustees[i] = key.NewKeyPair(suite)
+		X[i] = trustees[i].Public
+	}
+	H := BaseForCommittee(suite, X)
+
+	kp := key.NewKeyPair(suite)
+	escrow, err := EscrowKeyPair(kp, H, X, t)
+	require.Nil(test, err)
+	ok, _, err := VerifyTranscript(suite, escrow.Transcript)
+	require.Nil(test, err)
+	require.True(test, ok)
+
+	// every trustee checks and decrypts its share, two of them wrongly
+	decShares := make([]*PubVerShare, n)
+	for i, encShare := range escrow.Transcript.EncShares {
+		sH := escrow.Transcript.PubPoly.Eval(encShare.S.I).V
+		decShares[i], err = DecShare(suite, H, X[i], sH, trustees[i].Secret, encShare)
+		require.Nil(test, err)
+	}
+	decShares[0].S.V = suite.Point().Pick(random.Stream)
+	decShares[3].S.V = suite.Point().Pick(random.Stream)
+
+	recovered, err := RecoverEscrowed(suite, escrow, decShares, t)
+	require.Nil(test, err)
+	require.True(test, recovered.Equal(&key.Pair{Suite: kp.Suite, Public: kp.Public, Secret: kp.Secret}))
+
+	// one more bad share leaves too few
+	good := decShares[4].S.V
+	decShares[4].S.V = suite.Point().Pick(random.Stream)
+	_, err = RecoverEscrowed(suite, escrow, decShares, t)
+	require.NotNil(test, err)
+	decShares[4].S.V = good
+
+	// the secret must match the public key of the escrow
+	escrow.Public = key.NewKeyPair(suite).Public
+	_, err = RecoverEscrowed(suite, escrow, decShares, t)
+	mismatch := &key.Pair{Suite: suite, Public: escrow.Public, Secret: kp.Secret}
+	require.Equal(test, mismatch.Validate(), err)
+
+	_, err = EscrowKeyPair(&key.Pair{Suite: suite, Public: kp.Public}, H, X, t)
+	require.Equal(test, errorEscrowSecret, err)
+}
@@ -0,0 +1,105 @@
+package pvss
+
+import (
+	"bytes"
+	"errors"
+)
+
+var errorMerkleIndex = errors.New("share index out of range")
+
+// Leaves and inner nodes of the Merkle tree are hashed with distinct prefixes,
+// so that an inner node can't be passed off as a share.
+const (
+	merkleLeaf = 0
+	merkleNode = 1
+)
+
+// ShareMerkleRoot returns the root of a Merkle tree over the encrypted
+// shares, in order, using the hash function of the suite. Each leaf is the
+// hash of a serialized share, with the same encoding as in the envelopes. At
+// each level, an odd node out is promoted as is to the next level. The root
+// of an empty list is the hash of the empty string.
+func ShareMerkleRoot(suite Suite, encShares []*PubVerShare) []byte {
+	if len(encShares) == 0 {
+		return suite.Hash().Sum(nil)
+	}
+	level := merkleLeaves(suite, encShares)
+	for len(level) > 1 {
+		level = merkleLevel(suite, level)
+	}
+	return level[0]
+}
+
+// ShareMerkleProof returns the inclusion proof of the i-th encrypted share in
+// the tree of ShareMerkleRoot: the sibling hashes from the leaf up to the
+// root, skipping the levels where the node is promoted.
+func ShareMerkleProof(suite Suite, encShares []*PubVerShare, i int) ([][]byte, error) {
+	if i < 0 || i >= len(encShares) {
+		return nil, errorMerkleIndex
+	}
+	var proof [][]byte
+	level := merkleLeaves(suite, encShares)
+	for len(level) > 1 {
+		if sibling := i ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = merkleLevel(suite, level)
+		i /= 2
+	}
+	return proof, nil
+}
+
+// VerifyShareMerkleProof checks that encShare is the i-th of n shares under
+// the given root.
+func VerifyShareMerkleProof(suite Suite, root []byte, encShare *PubVerShare, i, n int, proof [][]byte) bool {
+	if i < 0 || i >= n {
+		return false
+	}
+	h := merkleLeaves(suite, []*PubVerShare{encShare})[0]
+	for ; n > 1; n = (n + 1) / 2 {
+		if i^1 < n {
+			if len(proof) == 0 {
+				return false
+			}
+			if i%2 == 0 {
+				h = merkleHash(suite, h, proof[0])
+			} else {
+				h = merkleHash(suite, proof[0], h)
+			}
+			proof = proof[1:]
+		}
+		i /= 2
+	}
+	return len(proof) == 0 && bytes.Equal(h, root)
+}
+
+func merkleLeaves(suite Suite, encShares []*PubVerShare) [][]byte {
+	leaves := make([][]byte, len(encShares))
+	for i, s := range encShares {
+		h := suite.Hash()
+		_, _ = h.Write([]byte{merkleLeaf})
+		_ = writePubVerShare(h, s)
+		leaves[i] = h.Sum(nil)
+	}
+	return leaves
+}
+
+func merkleLevel(suite Suite, level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+		} else {
+			next = append(next, merkleHash(suite, level[i], level[i+1]))
+		}
+	}
+	return next
+}
+
+func merkleHash(suite Suite, left, right []byte) []byte {
+	h := suite.Hash()
+	_, _ = h.Write([]byte{merkleNode})
+	_, _ = h.Write(left)
+	_, _ = h.Write(right)
+	return h.Sum(nil)
+}
@@ -0,0 +1,40 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSShareMerkle(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	for _, n := range []int{1, 2, 5, 8} {
+		_, X := newCommittee(suite, n)
+		encShares, _, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), (n+1)/2)
+		require.Nil(test, err)
+
+		root := ShareMerkleRoot(suite, encShares)
+		require.Equal(test, root, ShareMerkleRoot(suite, encShares))
+		for i := range encShares {
+			proof, err := ShareMerkleProof(suite, encShares, i)
+			require.Nil(test, err)
+			require.True(test, VerifyShareMerkleProof(suite, root, encShares[i], i, n, proof))
+			if n > 1 {
+				// wrong position
+				require.False(test, VerifyShareMerkleProof(suite, root, encShares[i], (i+1)%n, n, proof))
+			}
+		}
+		_, err = ShareMerkleProof(suite, encShares, n)
+		require.Equal(test, errorMerkleIndex, err)
+
+		// altering a share changes the root and invalidates its proof
+		proof, err := ShareMerkleProof(suite, encShares, 0)
+		require.Nil(test, err)
+		encShares[0].S.V = suite.Point().Add(encShares[0].S.V, suite.Point().Base())
+		require.NotEqual(test, root, ShareMerkleRoot(suite, encShares))
+		require.False(test, VerifyShareMerkleProof(suite, root, encShares[0], 0, n, proof))
+	}
+}
@@ -0,0 +1,85 @@
+package pvss
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errorPolyProof = errors.New("invalid proof of knowledge of the polynomial")
+
+// PolyProof is a non-interactive proof of knowledge of the coefficients a_j
+// committed as A_j = a_j*H in a public commitment polynomial. It is made of
+// one Schnorr proof of knowledge per coefficient, with a common challenge.
+type PolyProof struct {
+	C kyber.Scalar   // challenge
+	R []kyber.Scalar // responses, one per coefficient
+}
+
+// ProvePolyKnowledge proves the knowledge of the coefficients of priPoly,
+// including its secret constant term, without revealing them. The proof is
+// checked against the commitment priPoly.Commit(H) with VerifyPolyKnowledge.
+func ProvePolyKnowledge(suite Suite, priPoly *share.PriPoly, H kyber.Point) (*PolyProof, error) {
+	coeffs := priPoly.Coefficients()
+	commits := make([]kyber.Point, len(coeffs))
+	v := make([]kyber.Scalar, len(coeffs))
+	V := make([]kyber.Point, len(coeffs))
+	for j, a := range coeffs {
+		commits[j] = suite.Point().Mul(a, H)
+		v[j] = suite.Scalar().Pick(random.Stream)
+		V[j] = suite.Point().Mul(v[j], H)
+	}
+	c, err := polyChallenge(suite, H, commits, V)
+	if err != nil {
+		return nil, err
+	}
+	r := make([]kyber.Scalar, len(coeffs))
+	for j, a := range coeffs {
+		r[j] = suite.Scalar().Mul(a, c)
+		r[j].Sub(v[j], r[j])
+	}
+	return &PolyProof{c, r}, nil
+}
+
+// VerifyPolyKnowledge checks a proof created by ProvePolyKnowledge against the
+// public commitment polynomial, whose commitments are taken with respect to
+// the base point H.
+func VerifyPolyKnowledge(suite Suite, pubPoly *share.PubPoly, H kyber.Point, proof *PolyProof) error {
+	_, commits := pubPoly.Info()
+	if proof == nil || proof.C == nil || len(proof.R) != len(commits) {
+		return errorPolyProof
+	}
+	// recompute the Schnorr commitments V_j = r_j*H + c*A_j
+	V := make([]kyber.Point, len(commits))
+	tmp := suite.Point()
+	for j, A := range commits {
+		if proof.R[j] == nil {
+			return errorPolyProof
+		}
+		V[j] = suite.Point().Mul(proof.R[j], H)
+		V[j].Add(V[j], tmp.Mul(proof.C, A))
+	}
+	c, err := polyChallenge(suite, H, commits, V)
+	if err != nil {
+		return err
+	}
+	if !c.Equal(proof.C) {
+		return errorPolyProof
+	}
+	return nil
+}
+
+// polyChallenge hashes the base point, the commitments and the Schnorr
+// commitments to the challenge scalar.
+func polyChallenge(suite Suite, H kyber.Point, commits, V []kyber.Point) (kyber.Scalar, error) {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("pvss-poly-knowledge"))
+	for _, P := range append(append([]kyber.Point{H}, commits...), V...) {
+		if _, err := P.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	return suite.Scalar().Pick(suite.Cipher(h.Sum(nil))), nil
+}
@@ -0,0 +1,41 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSPolyKnowledge(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	t := 4
+	priPoly := share.NewPriPoly(suite, t, nil, random.Stream)
+	pubPoly := priPoly.Commit(H)
+
+	proof, err := ProvePolyKnowledge(suite, priPoly, H)
+	require.Nil(test, err)
+	require.Nil(test, VerifyPolyKnowledge(suite, pubPoly, H, proof))
+
+	// The proof doesn't hold for another polynomial or base point
+	other := share.NewPriPoly(suite, t, nil, random.Stream).Commit(H)
+	require.Equal(test, errorPolyProof, VerifyPolyKnowledge(suite, other, H, proof))
+	require.Equal(test, errorPolyProof, VerifyPolyKnowledge(suite, priPoly.Commit(suite.Point().Base()), suite.Point().Base(), proof))
+
+	// A forged proof, with random responses, fails
+	forged := &PolyProof{C: proof.C, R: make([]kyber.Scalar, t)}
+	for j := range forged.R {
+		forged.R[j] = suite.Scalar().Pick(random.Stream)
+	}
+	require.Equal(test, errorPolyProof, VerifyPolyKnowledge(suite, pubPoly, H, forged))
+
+	// So does a proof with a tampered response or a missing one
+	proof.R[1].Add(proof.R[1], suite.Scalar().One())
+	require.Equal(test, errorPolyProof, VerifyPolyKnowledge(suite, pubPoly, H, proof))
+	proof.R = proof.R[1:]
+	require.Equal(test, errorPolyProof, VerifyPolyKnowledge(suite, pubPoly, H, proof))
+}
@@ -14,7 +14,14 @@
 package pvss
 
 import (
+	"bytes"
+	"crypto/cipher"
 	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"sync"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/proof/dleq"
@@ -36,6 +43,43 @@ var errorTooFewShares = errors.New("not enough shares to recover secret")
 var errorDifferentLengths = errors.New("inputs of different lengths")
 var errorEncVerification = errors.New("verification of encrypted share failed")
 var errorDecVerification = errors.New("verification of decrypted share failed")
+var errorWorkers = errors.New("number of workers must be positive")
+var errorValidShare = errors.New("encrypted share is valid")
+var errorComplaint = errors.New("complaint is not justified")
+var errorPolyHash = errors.New("hash of the commitment polynomial does not match")
+var errorCommitHash = errors.New("hash of the share commitment does not match")
+var errorBase = errors.New("base point was not derived from the committee")
+var errorDecIndex = errors.New("decrypted share has another index than its encrypted share")
+var errorStartIndex = errors.New("index of the first new trustee must not be negative")
+var errorParanoidShares = errors.New("cross-checking the recovery needs more than t valid shares")
+var errorDegenerate = errors.New("commitment polynomial is of lower degree than the threshold requires")
+var errorMissingShare = errors.New("trustee did not provide a decrypted share")
+var errorSampleSize = errors.New("sample size must be between 1 and the number of shares")
+var errorSharedSecret = errors.New("commitment polynomial does not share the expected secret")
+var errorPolyBase = errors.New("commitment polynomial has another base point")
+var errorNonce = errors.New("nonce of the base point must not be empty")
+
+// ErrSuiteMismatch is returned by DecShare when its inputs are not elements of
+// the suite's group, typically because they were created with another suite.
+var ErrSuiteMismatch = errors.New("inputs do not belong to the group of the suite")
+
+// ErrEmptyInput is returned by VerifyEncShareBatch and
+// VerifyEncShareBatchWorkers when they are given no shares to verify, which
+// points to a misconfigured committee rather than to invalid shares.
+var ErrEmptyInput = errors.New("no encrypted shares to verify")
+
+// ErrHashMismatch is returned by VerifyTranscript when the transcript was
+// dealt with a suite whose Fiat-Shamir hash differs from the verifier's, e.g.
+// SHA-512 instead of SHA-256 on the same curve, so that the challenges of its
+// proofs were not derived the way the verifier derives them.
+var ErrHashMismatch = errors.New("transcript was dealt with another Fiat-Shamir hash than the suite's")
+
+// ErrBadChallenge is returned by VerifyTranscript when the proofs of the
+// encrypted shares hold but their challenge is not the one the verifier
+// derives from the transcript: the proofs were forged, or derived with
+// another hash than the suite's by a dealer whose transcript doesn't record
+// it.
+var ErrBadChallenge = errors.New("challenge of the proofs does not match the transcript")
 
 // PubVerShare is a public verifiable share.
 type PubVerShare struct {
@@ -43,23 +87,190 @@ type PubVerShare struct {
 	P dleq.Proof     // Proof
 }
 
+// BaseForCommittee derives the base point H deterministically from the public
+// keys X of the trustees. Using it as the base point of EncShares binds the
+// share distribution, and thus all its proofs, to this exact committee.
+func BaseForCommittee(suite Suite, X []kyber.Point) kyber.Point {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("pvss-committee"))
+	for _, x := range X {
+		_, _ = x.MarshalTo(h)
+	}
+	return suite.Point().Pick(suite.Cipher(h.Sum(nil)))
+}
+
+// VerifyBase checks that H was derived from the public keys X of the trustees
+// with BaseForCommittee, so that a dealer can't pick a base point of its own.
+func VerifyBase(suite Suite, X []kyber.Point, H kyber.Point) error {
+	if !BaseForCommittee(suite, X).Equal(H) {
+		return errorBase
+	}
+	return nil
+}
+
+// BaseForNonce derives the base point H from a nonce by hashing it to a
+// point, so that nobody knows its discrete logarithm. Fresh nonces give
+// independent base points, which makes the distributions of EncSharesWithNonce
+// unlinkable through their base points, unlike the ones using a base point of
+// BaseForCommittee.
+func BaseForNonce(suite Suite, nonce []byte) kyber.Point {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("pvss-nonce"))
+	_, _ = h.Write(nonce)
+	return suite.Point().Pick(suite.Cipher(h.Sum(nil)))
+}
+
+// VerifyBaseNonce checks that H was derived from the nonce with BaseForNonce.
+func VerifyBaseNonce(suite Suite, nonce []byte, H kyber.Point) error {
+	if len(nonce) == 0 {
+		return errorNonce
+	}
+	if !BaseForNonce(suite, nonce).Equal(H) {
+		return errorBase
+	}
+	return nil
+}
+
+// EncSharesWithNonce provides the same functionality as EncShares but with
+// the base point derived from the nonce with BaseForNonce, which must be
+// fresh, e.g. random, for every distribution. The nonce is returned in the
+// transcript, with which the verifiers check the base point with
+// VerifyBaseNonce.
+func EncSharesWithNonce(suite Suite, nonce []byte, X []kyber.Point, secret kyber.Scalar, t int) (*Transcript, error) {
+	if len(nonce) == 0 {
+		return nil, errorNonce
+	}
+	H := BaseForNonce(suite, nonce)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	if err != nil {
+		return nil, err
+	}
+	return &Transcript{H: H, X: X, EncShares: encShares, PubPoly: pubPoly, Nonce: nonce, Hash: HashID(suite)}, nil
+}
+
+// VerifySharedSecret checks that the dealer of a distribution shared the
+// secret whose commitment S = secret*H is known in advance, e.g. because it
+// was published before the distribution: the constant term of the commitment
+// polynomial, of base point H, must be S.
+func VerifySharedSecret(suite Suite, H kyber.Point, pubPoly *share.PubPoly, S kyber.Point) error {
+	base, _ := pubPoly.Info()
+	if base == nil {
+		base = suite.Point().Base()
+	}
+	if !base.Equal(H) {
+		return errorPolyBase
+	}
+	if !pubPoly.Commit().Equal(S) {
+		return errorSharedSecret
+	}
+	return nil
+}
+
+// DetectPolyReuse returns true if the public commitment polynomials of two
+// epochs share a commitment to a coefficient of the same degree. The
+// coefficients of fresh polynomials are random, so such a match means that
+// the dealer reused its polynomial, or at least its secret for a matching
+// constant term, which defeats forward security.
+func DetectPolyReuse(prevPubPoly, curPubPoly *share.PubPoly) bool {
+	_, prev := prevPubPoly.Info()
+	_, cur := curPubPoly.Info()
+	for i := 0; i < len(prev) && i < len(cur); i++ {
+		if prev[i].Equal(cur[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ThresholdError explains why ValidateThreshold rejected a threshold t for a
+// committee of n trustees.
+type ThresholdError struct {
+	T      int
+	N      int
+	Reason string
+}
+
+func (e *ThresholdError) Error() string {
+	return fmt.Sprintf("pvss: threshold %d of %d: %s", e.T, e.N, e.Reason)
+}
+
+// ValidateThreshold checks that a secret shared with threshold t among n
+// trustees can be recovered, i.e. that 0 < t <= n, and returns a
+// *ThresholdError otherwise. It returns warn set if t > n/2+1: the secret can
+// be recovered, but only with the cooperation of more than a majority of the
+// trustees, and the caller may prefer t <= n/2+1.
+func ValidateThreshold(t, n int) (warn bool, err error) {
+	switch {
+	case n <= 0:
+		return false, &ThresholdError{t, n, "the committee must have at least one trustee"}
+	case t <= 0:
+		return false, &ThresholdError{t, n, "the threshold must be positive"}
+	case t > n:
+		return false, &ThresholdError{t, n, "the threshold exceeds the number of trustees, the secret could never be recovered"}
+	}
+	return t > n/2+1, nil
+}
+
 // EncShares creates a list of encrypted publicly verifiable PVSS shares for
 // the given secret and the list of public keys X using the sharing threshold
 // t and the base point H. The function returns the list of shares and the
 // public commitment polynomial.
 func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar, t int) ([]*PubVerShare, *share.PubPoly, error) {
-	n := len(X)
-	encShares := make([]*PubVerShare, n)
+	encShares, pubPoly, _, err := EncSharesWithPrivate(suite, H, X, secret, t)
+	return encShares, pubPoly, err
+}
 
+// EncSharesWithPrivate provides the same functionality as EncShares but also
+// returns the private shares, in the order of X, so that the dealer can
+// check its own encryption or reconstruct the secret locally. Any t of these
+// shares reveal the secret: a dealer keeping them must protect them like the
+// secret itself, e.g. encrypted at rest, and loses the guarantee that only
+// the trustees together can recover it.
+func EncSharesWithPrivate(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar, t int) ([]*PubVerShare, *share.PubPoly, []*share.PriShare, error) {
 	// Create secret sharing polynomial
 	priPoly := share.NewPriPoly(suite, t, secret, random.Stream)
 
 	// Create secret set of shares
-	priShares := priPoly.Shares(n)
+	priShares := priPoly.Shares(len(X))
 
 	// Create public polynomial commitments with respect to basis H
 	pubPoly := priPoly.Commit(H)
 
+	encShares, err := encryptShares(suite, H, X, priShares)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return encShares, pubPoly, priShares, nil
+}
+
+// AddTrustees extends the committee of a distribution whose dealer still
+// holds the sharing polynomial priPoly, e.g. recovered with
+// share.RecoverPriPoly from the private shares returned by
+// EncSharesWithPrivate: it returns the encrypted shares of the
+// new trustees, with public keys newX, at the indices startIndex,
+// startIndex+1, ..., consistent with the public commitment polynomial of the
+// distribution, which is unchanged. The threshold is unchanged too, so the new
+// trustees can reconstruct the secret together with the former ones.
+// startIndex must not be the index of a former trustee, typically it is the
+// size of the former committee.
+func AddTrustees(suite Suite, H kyber.Point, priPoly *share.PriPoly, newX []kyber.Point, startIndex int) ([]*PubVerShare, error) {
+	if startIndex < 0 {
+		return nil, errorStartIndex
+	}
+	priShares := make([]*share.PriShare, len(newX))
+	for i := range priShares {
+		priShares[i] = priPoly.Eval(startIndex + i)
+	}
+	return encryptShares(suite, H, newX, priShares)
+}
+
+// encryptShares encrypts the private shares to the public keys X, in the same
+// order, and proves the encryption consistent with the commitments to the
+// shares with respect to H.
+func encryptShares(suite Suite, H kyber.Point, X []kyber.Point, priShares []*share.PriShare) ([]*PubVerShare, error) {
+	n := len(X)
+	encShares := make([]*PubVerShare, n)
+
 	// Prepare data for encryption consistency proofs ...
 	indices := make([]int, n)
 	values := make([]kyber.Scalar, n)
@@ -71,17 +282,16 @@ func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar,
 	}
 
 	// Create NIZK discrete-logarithm equality proofs
-	proofs, _, sX, err := dleq.NewDLEQProofBatch(suite, HS, X, values)
+	proofs, _, sX, err := dleq.NewDLEQProofBatch(suite, HS, X, values, random.Stream)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	for i := 0; i < n; i++ {
 		ps := &share.PubShare{I: indices[i], V: sX[i]}
 		encShares[i] = &PubVerShare{*ps, *proofs[i]}
 	}
-
-	return encShares, pubPoly, nil
+	return encShares, nil
 }
 
 // VerifyEncShare checks that the encrypted share sX satisfies
@@ -94,6 +304,65 @@ func VerifyEncShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, e
 	return nil
 }
 
+// CommitmentShare returns the share commitment sH of the encrypted share with
+// the given index, i.e. the public commitment polynomial evaluated for that
+// share, as expected by VerifyEncShare. The index is the share index S.I of the
+// encrypted share, starting at 0, and not the point of evaluation of the
+// polynomial. It lets a verifier checking a single share avoid computing the
+// commitments of all the shares.
+func CommitmentShare(pubPoly *share.PubPoly, index int) kyber.Point {
+	return pubPoly.Eval(index).V
+}
+
+// VerifyEncShareWithPolyHash provides the same functionality as
+// VerifyEncShare but takes the full public commitment polynomial together
+// with its expected hash, e.g. the only value posted on a public ledger. The
+// polynomial is rejected if its hash does not match before any point checks
+// are performed.
+func VerifyEncShareWithPolyHash(suite Suite, H kyber.Point, X kyber.Point, pubPoly *share.PubPoly, polyHash []byte, encShare *PubVerShare) error {
+	if !bytes.Equal(pubPoly.Hash(suite), polyHash) {
+		return errorPolyHash
+	}
+	sH := pubPoly.Eval(encShare.S.I).V
+	return VerifyEncShare(suite, H, X, sH, encShare)
+}
+
+// HashCommitment returns the hash of the share commitment sH, with the hash
+// function of the suite, as expected by VerifyEncShareHashed.
+func HashCommitment(suite Suite, sH kyber.Point) []byte {
+	h := suite.Hash()
+	_, _ = sH.MarshalTo(h)
+	return h.Sum(nil)
+}
+
+// VerifyEncShareHashed provides the same functionality as VerifyEncShare for
+// verifiers that only store the hash of the share commitment sH, computed
+// with HashCommitment. The claimed sH is rejected if its hash does not match
+// before any point checks are performed.
+func VerifyEncShareHashed(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, sHHash []byte, encShare *PubVerShare) error {
+	if !bytes.Equal(HashCommitment(suite, sH), sHHash) {
+		return errorCommitHash
+	}
+	return VerifyEncShare(suite, H, X, sH, encShare)
+}
+
+// VerifyShareIndices checks that the encrypted shares are in the order of the
+// trustees they are encrypted to: the share at position i, meant for X[i],
+// must have the index startIndex+i. startIndex is 0 for the shares of
+// EncShares, and the one given to AddTrustees for the shares of the new
+// trustees. VerifyEncShareBatch pairs the shares with the public keys by
+// position and checks each share against the commitment at its own index, so
+// it does not catch shares swapped between trustees, for which this check
+// returns an error naming the first misplaced share.
+func VerifyShareIndices(encShares []*PubVerShare, startIndex int) error {
+	for i, s := range encShares {
+		if s.S.I != startIndex+i {
+			return fmt.Errorf("share at position %d has index %d instead of %d", i, s.S.I, startIndex+i)
+		}
+	}
+	return nil
+}
+
 // VerifyEncShareBatch provides the same functionality as VerifyEncShare but for
 // slices of encrypted shares. The function returns the valid encrypted shares
 // together with the corresponding public keys.
@@ -101,6 +370,9 @@ func VerifyEncShareBatch(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber
 	if len(X) != len(sH) || len(sH) != len(encShares) {
 		return nil, nil, errorDifferentLengths
 	}
+	if len(X) == 0 {
+		return nil, nil, ErrEmptyInput
+	}
 	var K []kyber.Point  // good public keys
 	var E []*PubVerShare // good encrypted shares
 	for i := 0; i < len(X); i++ {
@@ -112,15 +384,201 @@ func VerifyEncShareBatch(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber
 	return K, E, nil
 }
 
+// VerifyEncShareBatchWorkers provides the same functionality as
+// VerifyEncShareBatch but verifies the encrypted shares concurrently using at
+// most maxWorkers goroutines. With maxWorkers == 1 the shares are verified
+// sequentially. The order of the returned public keys and shares is the same
+// as for VerifyEncShareBatch, regardless of the number of workers.
+func VerifyEncShareBatchWorkers(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber.Point, encShares []*PubVerShare, maxWorkers int) ([]kyber.Point, []*PubVerShare, error) {
+	if len(X) != len(sH) || len(sH) != len(encShares) {
+		return nil, nil, errorDifferentLengths
+	}
+	if len(X) == 0 {
+		return nil, nil, ErrEmptyInput
+	}
+	if maxWorkers < 1 {
+		return nil, nil, errorWorkers
+	}
+	valid := make([]bool, len(X))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < maxWorkers && w < len(X); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				valid[i] = VerifyEncShare(suite, H, X[i], sH[i], encShares[i]) == nil
+			}
+		}()
+	}
+	for i := range X {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var K []kyber.Point  // good public keys
+	var E []*PubVerShare // good encrypted shares
+	for i := range valid {
+		if valid[i] {
+			K = append(K, X[i])
+			E = append(E, encShares[i])
+		}
+	}
+	return K, E, nil
+}
+
+// VerifySample verifies a random sample of sampleSize of the encrypted shares,
+// drawn without replacement using rand, for auditors of large committees who
+// accept a probabilistic assurance. It returns the positions of the sampled
+// shares in ascending order and, aligned with them, whether each one passed
+// VerifyEncShare.
+//
+// If k of the n shares are invalid, all of them are missed with probability
+// C(n-k, m)/C(n, m) <= (1-k/n)^m for a sample of size m: with 10% of bad
+// shares, a sample of 44 shares catches at least one with probability 99%.
+// The guarantee only holds if the dealer cannot predict the sample, so rand
+// must not be known to the dealer before the shares are published.
+func VerifySample(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber.Point, encShares []*PubVerShare, sampleSize int, rand cipher.Stream) ([]int, []bool, error) {
+	if len(X) != len(sH) || len(sH) != len(encShares) {
+		return nil, nil, errorDifferentLengths
+	}
+	if len(X) == 0 {
+		return nil, nil, ErrEmptyInput
+	}
+	if sampleSize < 1 || sampleSize > len(X) {
+		return nil, nil, errorSampleSize
+	}
+
+	// partial Fisher-Yates shuffle picking sampleSize positions at random
+	positions := make([]int, len(X))
+	for i := range positions {
+		positions[i] = i
+	}
+	// (random.Int never returns 0, hence the shifted range)
+	for i := 0; i < sampleSize; i++ {
+		j := i - 1 + int(random.Int(big.NewInt(int64(len(X)-i+1)), rand).Int64())
+		positions[i], positions[j] = positions[j], positions[i]
+	}
+	sample := positions[:sampleSize]
+	sort.Ints(sample)
+
+	valid := make([]bool, sampleSize)
+	for k, i := range sample {
+		valid[k] = VerifyEncShare(suite, H, X[i], sH[i], encShares[i]) == nil
+	}
+	return sample, valid, nil
+}
+
+// Complaint is a publicly verifiable accusation against the dealer, issued
+// when an encrypted share does not pass verification.
+type Complaint struct {
+	X        kyber.Point  // Public key of the trustee the share is encrypted for
+	SH       kyber.Point  // Public commitment evaluated at the share's index
+	EncShare *PubVerShare // Incriminated encrypted share
+	Reason   string       // Why the encrypted share is invalid
+}
+
+// NewComplaint creates a complaint against the encrypted share encShare. It
+// returns an error if the encrypted share is valid, as there is nothing to
+// complain about.
+func NewComplaint(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, encShare *PubVerShare) (*Complaint, error) {
+	err := VerifyEncShare(suite, H, X, sH, encShare)
+	if err == nil {
+		return nil, errorValidShare
+	}
+	return &Complaint{X: X, SH: sH, EncShare: encShare, Reason: err.Error()}, nil
+}
+
+// VerifyComplaint checks that the complaint is justified against the deal the
+// dealer published, made of the public keys X of the trustees, the encrypted
+// shares and the public commitment polynomial: the incriminated share must be
+// the published share with the same index, the complaint must be issued for the
+// key of the trustee this share is encrypted to, and its commitment must be the
+// one of the polynomial at this index. Finally, the share must indeed fail
+// verification. It returns nil if the complaint is justified and an error
+// otherwise.
+func VerifyComplaint(suite Suite, H kyber.Point, X []kyber.Point, encShares []*PubVerShare, pubPoly *share.PubPoly, c *Complaint) error {
+	if c == nil || c.EncShare == nil || c.X == nil || c.SH == nil {
+		return errorComplaint
+	}
+	if len(X) != len(encShares) {
+		return errorDifferentLengths
+	}
+	pos := -1
+	for i, s := range encShares {
+		if s.S.I == c.EncShare.S.I {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 || !X[pos].Equal(c.X) || !samePubVerShare(encShares[pos], c.EncShare) {
+		return errorComplaint
+	}
+	if !pubPoly.Eval(c.EncShare.S.I).V.Equal(c.SH) {
+		return errorComplaint
+	}
+	if err := VerifyEncShare(suite, H, c.X, c.SH, c.EncShare); err == nil {
+		return errorComplaint
+	}
+	return nil
+}
+
+// samePubVerShare tells whether the incriminated share s is the published share
+// p, proof included.
+func samePubVerShare(p, s *PubVerShare) bool {
+	if s.S.V == nil || s.P.C == nil || s.P.R == nil || s.P.VG == nil || s.P.VH == nil {
+		return false
+	}
+	return p.S.I == s.S.I && p.S.V.Equal(s.S.V) &&
+		p.P.C.Equal(s.P.C) && p.P.R.Equal(s.P.R) &&
+		p.P.VG.Equal(s.P.VG) && p.P.VH.Equal(s.P.VH)
+}
+
 // DecShare first verifies the encrypted share against the encryption
 // consistency proof and, if valid, decrypts it and creates a decryption
 // consistency proof.
 func DecShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, x kyber.Scalar, encShare *PubVerShare) (*PubVerShare, error) {
+	if !inGroup(suite, []kyber.Point{H, X, sH, encShare.S.V, encShare.P.VG, encShare.P.VH},
+		[]kyber.Scalar{x, encShare.P.C, encShare.P.R}) {
+		return nil, ErrSuiteMismatch
+	}
 	if err := VerifyEncShare(suite, H, X, sH, encShare); err != nil {
 		return nil, err
 	}
-	G := suite.Point().Base()
-	V := suite.Point().Mul(suite.Scalar().Inv(x), encShare.S.V) // decryption: x^{-1} * (xS)
+	return decShare(suite, x, suite.Scalar().Inv(x), encShare)
+}
+
+// inGroup checks that the points and scalars have the concrete types of the
+// suite's elements and the encoding sizes of its group, and that the points
+// decode as points of the group.
+func inGroup(suite Suite, points []kyber.Point, scalars []kyber.Scalar) bool {
+	pointType := reflect.TypeOf(suite.Point())
+	for _, p := range points {
+		if reflect.TypeOf(p) != pointType {
+			return false
+		}
+		buf, err := p.MarshalBinary()
+		if err != nil || len(buf) != suite.PointLen() {
+			return false
+		}
+		if err := suite.Point().UnmarshalBinary(buf); err != nil {
+			return false
+		}
+	}
+	scalarType := reflect.TypeOf(suite.Scalar())
+	for _, s := range scalars {
+		if reflect.TypeOf(s) != scalarType || s.MarshalSize() != suite.ScalarLen() {
+			return false
+		}
+	}
+	return true
+}
+
+// decShare decrypts encShare given the private key x and its inverse xi.
+func decShare(suite Suite, x kyber.Scalar, xi kyber.Scalar, encShare *PubVerShare) (*PubVerShare, error) {
+	G := suite.Generator()
+	V := suite.Point().Mul(xi, encShare.S.V) // decryption: x^{-1} * (xS)
 	ps := &share.PubShare{I: encShare.S.I, V: V}
 	P, _, _, err := dleq.NewDLEQProof(suite, G, V, x)
 	if err != nil {
@@ -129,6 +587,32 @@ func DecShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, x kyber
 	return &PubVerShare{*ps, *P}, nil
 }
 
+// DecShareMulti decrypts several encrypted shares held by the same trustee,
+// with public key X and private key x, such as a trustee holding multiple
+// share indices. Each share is verified against the corresponding sH before
+// decryption and invalid ones are skipped. The function returns the valid
+// encrypted shares and the decrypted shares, in the same order.
+func DecShareMulti(suite Suite, H kyber.Point, X kyber.Point, sHs []kyber.Point, x kyber.Scalar, encShares []*PubVerShare) ([]*PubVerShare, []*PubVerShare, error) {
+	if len(sHs) != len(encShares) {
+		return nil, nil, errorDifferentLengths
+	}
+	xi := suite.Scalar().Inv(x)
+	var E []*PubVerShare // good encrypted shares
+	var D []*PubVerShare // good decrypted shares
+	for i := 0; i < len(encShares); i++ {
+		if err := VerifyEncShare(suite, H, X, sHs[i], encShares[i]); err != nil {
+			continue
+		}
+		ds, err := decShare(suite, x, xi, encShares[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		E = append(E, encShares[i])
+		D = append(D, ds)
+	}
+	return E, D, nil
+}
+
 // DecShareBatch provides the same functionality as DecShare but for slices of
 // encrypted shares. The function returns the valid encrypted and decrypted
 // shares as well as the corresponding public keys.
@@ -158,6 +642,23 @@ func VerifyDecShare(suite Suite, G kyber.Point, X kyber.Point, encShare *PubVerS
 	return nil
 }
 
+// VerifyDecShareOwner provides the same functionality as VerifyDecShare but
+// also checks that the decrypted share has the index of encShare, the
+// encrypted share of the trustee with public key X, and that the challenge of
+// its proof is the one of DecShare, see dleq.Proof.VerifySingle. The proof of a
+// decrypted share binds its value to X but not its index, so a combiner could
+// otherwise relabel a valid decrypted share with the index of another trustee,
+// and without the challenge anyone could forge a proof for any value.
+func VerifyDecShareOwner(suite Suite, G kyber.Point, X kyber.Point, encShare *PubVerShare, decShare *PubVerShare) error {
+	if decShare.S.I != encShare.S.I {
+		return errorDecIndex
+	}
+	if err := decShare.P.VerifySingle(suite, G, decShare.S.V, X, encShare.S.V); err != nil {
+		return errorDecVerification
+	}
+	return nil
+}
+
 // VerifyDecShareBatch provides the same functionality as VerifyDecShare but for
 // slices of decrypted shares. The function returns the the valid decrypted shares.
 func VerifyDecShareBatch(suite Suite, G kyber.Point, X []kyber.Point, encShares []*PubVerShare, decShares []*PubVerShare) ([]*PubVerShare, error) {
@@ -173,6 +674,65 @@ func VerifyDecShareBatch(suite Suite, G kyber.Point, X []kyber.Point, encShares
 	return D, nil
 }
 
+// VerifyDecShareBatchDetailed provides the same functionality as
+// VerifyDecShareBatch but reports the outcome for every index, so that a bad
+// decryption can be attributed to its trustee. The returned slices are
+// aligned with the inputs: valid[i] is true iff errs[i] is nil.
+func VerifyDecShareBatchDetailed(suite Suite, G kyber.Point, X []kyber.Point, encShares []*PubVerShare, decShares []*PubVerShare) ([]bool, []error, error) {
+	if len(X) != len(encShares) || len(encShares) != len(decShares) {
+		return nil, nil, errorDifferentLengths
+	}
+	valid := make([]bool, len(X))
+	errs := make([]error, len(X))
+	for i := 0; i < len(X); i++ {
+		errs[i] = VerifyDecShare(suite, G, X[i], encShares[i], decShares[i])
+		valid[i] = errs[i] == nil
+	}
+	return valid, errs, nil
+}
+
+// AuditReport is the outcome of AuditDecryption. Valid and Errors are aligned
+// with the trustees: Valid[i] is true iff Errors[i] is nil.
+type AuditReport struct {
+	Valid        []bool
+	Errors       []error
+	ValidCount   int
+	Threshold    int
+	ThresholdMet bool
+}
+
+// AuditDecryption checks every decrypted share of a distribution, as an
+// external auditor would before accepting a recovered secret, and reports
+// which trustees decrypted correctly and whether at least t of them did. Each
+// decrypted share is checked with VerifyDecShareOwner against the encrypted
+// share and the public key of its trustee; a nil decrypted share marks a
+// trustee that did not answer. The error is only set if the inputs are of
+// different lengths.
+func AuditDecryption(suite Suite, G kyber.Point, X []kyber.Point, encShares []*PubVerShare, decShares []*PubVerShare, t int) (*AuditReport, error) {
+	if len(X) != len(encShares) || len(encShares) != len(decShares) {
+		return nil, errorDifferentLengths
+	}
+	r := &AuditReport{
+		Valid:     make([]bool, len(X)),
+		Errors:    make([]error, len(X)),
+		Threshold: t,
+	}
+	for i := range X {
+		switch {
+		case decShares[i] == nil:
+			r.Errors[i] = errorMissingShare
+		default:
+			r.Errors[i] = VerifyDecShareOwner(suite, G, X[i], encShares[i], decShares[i])
+		}
+		if r.Errors[i] == nil {
+			r.Valid[i] = true
+			r.ValidCount++
+		}
+	}
+	r.ThresholdMet = r.ValidCount >= t
+	return r, nil
+}
+
 // RecoverSecret first verifies the given decrypted shares against their
 // decryption consistency proofs and then tries to recover the shared secret.
 func RecoverSecret(suite Suite, G kyber.Point, X []kyber.Point, encShares []*PubVerShare, decShares []*PubVerShare, t int, n int) (kyber.Point, error) {
@@ -189,3 +749,59 @@ func RecoverSecret(suite Suite, G kyber.Point, X []kyber.Point, encShares []*Pub
 	}
 	return share.RecoverCommit(suite, shares, t, n)
 }
+
+// RecoverSecretStrict provides the same functionality as RecoverSecret but
+// first checks that the commitment polynomial of the distribution has degree
+// exactly t-1, see share.PubPoly.IsFullDegree. It rejects the distributions of
+// a dealer who used a lower degree polynomial, whose secret fewer than t
+// trustees could recover.
+func RecoverSecretStrict(suite Suite, G kyber.Point, X []kyber.Point, pubPoly *share.PubPoly, encShares []*PubVerShare, decShares []*PubVerShare, t int, n int) (kyber.Point, error) {
+	if !pubPoly.IsFullDegree(t) {
+		return nil, errorDegenerate
+	}
+	return RecoverSecret(suite, G, X, encShares, decShares, t, n)
+}
+
+// RecoverSecretParanoid provides the same functionality as RecoverSecret but
+// cross-checks the recovered secret: the interpolation of the first t valid
+// decrypted shares is run again on the last t ones, and both results must
+// agree. Since there are more than t valid shares, the two subsets differ in at
+// least one share. This catches interpolation bugs as well as an inconsistent
+// share that passed verification, e.g. one a dealer encrypted off the
+// commitment polynomial, since such a share shifts both results by different
+// amounts. It requires more than t valid shares, and returns an error naming
+// the share indices of the second subset if the results disagree.
+func RecoverSecretParanoid(suite Suite, G kyber.Point, X []kyber.Point, encShares []*PubVerShare, decShares []*PubVerShare, t int, n int) (kyber.Point, error) {
+	D, err := VerifyDecShareBatch(suite, G, X, encShares, decShares)
+	if err != nil {
+		return nil, err
+	}
+	if len(D) < t {
+		return nil, errorTooFewShares
+	}
+	if len(D) == t {
+		return nil, errorParanoidShares
+	}
+	var shares []*share.PubShare
+	for _, s := range D {
+		shares = append(shares, &s.S)
+	}
+	secret, err := share.RecoverCommit(suite, shares, t, n)
+	if err != nil {
+		return nil, err
+	}
+
+	subset := shares[len(shares)-t:]
+	check, err := share.RecoverCommit(suite, subset, t, n)
+	if err != nil {
+		return nil, err
+	}
+	if !check.Equal(secret) {
+		indices := make([]int, t)
+		for i, s := range subset {
+			indices[i] = s.I
+		}
+		return nil, fmt.Errorf("recovered secret diverges when interpolating only the shares %v", indices)
+	}
+	return secret, nil
+}
@@ -1,26 +1,50 @@
 package pvss
 
 import (
+	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/require"
 )
 
+// The kyber suites satisfy Suite directly, without any adapter.
+var _ Suite = edwards25519.NewAES128SHA256Ed25519()
+
+// newCommittee returns the private and public keys of n trustees.
+func newCommittee(suite Suite, n int) ([]kyber.Scalar, []kyber.Point) {
+	x := make([]kyber.Scalar, n) // trustee private keys
+	X := make([]kyber.Point, n)  // trustee public keys
+	for i := range x {
+		x[i] = suite.Scalar().Pick(random.Stream)
+		X[i] = suite.Point().Mul(x[i], nil)
+	}
+	return x, X
+}
+
+// shareCommits returns the commitments sH of the encrypted shares, evaluated
+// from the commitment polynomial.
+func shareCommits(pubPoly *share.PubPoly, encShares []*PubVerShare) []kyber.Point {
+	sH := make([]kyber.Point, len(encShares))
+	for i, s := range encShares {
+		sH[i] = pubPoly.Eval(s.S.I).V
+	}
+	return sH
+}
+
 func TestPVSS(test *testing.T) {
 	suite := edwards25519.NewAES128SHA256Ed25519()
 	G := suite.Point().Base()
 	H := suite.Point().Pick(suite.Cipher([]byte("H")))
 	n := 10
 	t := 2*n/3 + 1
-	x := make([]kyber.Scalar, n) // trustee private keys
-	X := make([]kyber.Point, n)  // trustee public keys
-	for i := 0; i < n; i++ {
-		x[i] = suite.Scalar().Pick(random.Stream)
-		X[i] = suite.Point().Mul(x[i], nil)
-	}
+	x, X := newCommittee(suite, n)
 
 	// Scalar of shared secret
 	secret := suite.Scalar().Pick(random.Stream)
@@ -30,10 +54,7 @@ func TestPVSS(test *testing.T) {
 	require.Equal(test, err, nil)
 
 	// (2) Share decryption (trustees)
-	sH := make([]kyber.Point, n)
-	for i := 0; i < n; i++ {
-		sH[i] = pubPoly.Eval(encShares[i].S.I).V
-	}
+	sH := shareCommits(pubPoly, encShares)
 
 	var K []kyber.Point  // good public keys
 	var E []*PubVerShare // good encrypted shares
@@ -59,12 +80,7 @@ func TestPVSSDelete(test *testing.T) {
 	H := suite.Point().Pick(suite.Cipher([]byte("H")))
 	n := 10
 	t := 2*n/3 + 1
-	x := make([]kyber.Scalar, n) // trustee private keys
-	X := make([]kyber.Point, n)  // trustee public keys
-	for i := 0; i < n; i++ {
-		x[i] = suite.Scalar().Pick(random.Stream)
-		X[i] = suite.Point().Mul(x[i], nil)
-	}
+	x, X := newCommittee(suite, n)
 
 	// Scalar of shared secret
 	secret := suite.Scalar().Pick(random.Stream)
@@ -78,10 +94,7 @@ func TestPVSSDelete(test *testing.T) {
 	encShares[5].S.V = suite.Point().Null()
 
 	// (2) Share decryption (trustees)
-	sH := make([]kyber.Point, n)
-	for i := 0; i < n; i++ {
-		sH[i] = pubPoly.Eval(encShares[i].S.I).V
-	}
+	sH := shareCommits(pubPoly, encShares)
 
 	var K []kyber.Point  // good public keys
 	var E []*PubVerShare // good encrypted shares
@@ -110,12 +123,7 @@ func TestPVSSDeleteFail(test *testing.T) {
 	H := suite.Point().Pick(suite.Cipher([]byte("H")))
 	n := 10
 	t := 2*n/3 + 1
-	x := make([]kyber.Scalar, n) // trustee private keys
-	X := make([]kyber.Point, n)  // trustee public keys
-	for i := 0; i < n; i++ {
-		x[i] = suite.Scalar().Pick(random.Stream)
-		X[i] = suite.Point().Mul(x[i], nil)
-	}
+	x, X := newCommittee(suite, n)
 
 	// Scalar of shared secret
 	secret := suite.Scalar().Pick(random.Stream)
@@ -129,10 +137,7 @@ func TestPVSSDeleteFail(test *testing.T) {
 	encShares[5].S.V = suite.Point().Null()
 
 	// (2) Share decryption (trustees)
-	sH := make([]kyber.Point, n)
-	for i := 0; i < n; i++ {
-		sH[i] = pubPoly.Eval(encShares[i].S.I).V
-	}
+	sH := shareCommits(pubPoly, encShares)
 
 	var K []kyber.Point  // good public keys
 	var E []*PubVerShare // good encrypted shares
@@ -161,12 +166,7 @@ func TestPVSSBatch(test *testing.T) {
 	H := suite.Point().Pick(suite.Cipher([]byte("H")))
 	n := 5
 	t := 2*n/3 + 1
-	x := make([]kyber.Scalar, n) // trustee private keys
-	X := make([]kyber.Point, n)  // trustee public keys
-	for i := 0; i < n; i++ {
-		x[i] = suite.Scalar().Pick(random.Stream)
-		X[i] = suite.Point().Mul(x[i], nil)
-	}
+	x, X := newCommittee(suite, n)
 
 	// (1) Share distribution (multiple dealers)
 	s0 := suite.Scalar().Pick(random.Stream)
@@ -257,3 +257,742 @@ func TestPVSSBatch(test *testing.T) {
 	require.True(test, suite.Point().Mul(s1, nil).Equal(S1))
 	require.True(test, suite.Point().Mul(s2, nil).Equal(S2))
 }
+
+func TestPVSSBaseForCommittee(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	n := 10
+	t := 2*n/3 + 1
+	x, X := newCommittee(suite, n)
+
+	H := BaseForCommittee(suite, X)
+	require.True(test, H.Equal(BaseForCommittee(suite, X)))
+
+	// Changing one trustee's key changes the base point
+	Y := make([]kyber.Point, n)
+	copy(Y, X)
+	Y[3] = suite.Point().Pick(random.Stream)
+	require.False(test, H.Equal(BaseForCommittee(suite, Y)))
+
+	// The derived base point can be used for a full sharing
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Equal(test, err, nil)
+
+	var K []kyber.Point
+	var E []*PubVerShare
+	var D []*PubVerShare
+	for i := 0; i < n; i++ {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		if ds, err := DecShare(suite, H, X[i], sH, x[i], encShares[i]); err == nil {
+			K = append(K, X[i])
+			E = append(E, encShares[i])
+			D = append(D, ds)
+		}
+	}
+	recovered, err := RecoverSecret(suite, G, K, E, D, t, n)
+	require.Equal(test, err, nil)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+}
+
+func TestPVSSEncSharesWithPrivate(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	t := 3
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, priShares, err := EncSharesWithPrivate(suite, H, X, secret, t)
+	require.Nil(test, err)
+	require.Equal(test, n, len(priShares))
+
+	for i, s := range priShares {
+		// The private share matches the public polynomial ...
+		require.Equal(test, encShares[i].S.I, s.I)
+		require.True(test, pubPoly.Check(s))
+		// ... and its encryption to the trustee
+		require.True(test, suite.Point().Mul(s.V, X[i]).Equal(encShares[i].S.V))
+		sH := pubPoly.Eval(s.I).V
+		require.Nil(test, VerifyEncShare(suite, H, X[i], sH, encShares[i]))
+	}
+
+	// The dealer can reconstruct the secret locally
+	recovered, err := share.RecoverSecret(suite, priShares[n-t:], t, n)
+	require.Nil(test, err)
+	require.True(test, secret.Equal(recovered))
+}
+
+func TestPVSSDetectPolyReuse(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	t := 3
+	priPoly := share.NewPriPoly(suite, t, nil, random.Stream)
+	prev := priPoly.Commit(H)
+
+	// The same polynomial, committed again
+	require.True(test, DetectPolyReuse(prev, priPoly.Commit(H)))
+
+	// A fresh polynomial
+	fresh := share.NewPriPoly(suite, t, nil, random.Stream).Commit(H)
+	require.False(test, DetectPolyReuse(prev, fresh))
+
+	// A fresh polynomial sharing the same secret
+	sameSecret := share.NewPriPoly(suite, t+1, priPoly.Secret(), random.Stream).Commit(H)
+	require.True(test, DetectPolyReuse(prev, sameSecret))
+}
+
+func TestPVSSVerifyBase(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 5
+	_, X := newCommittee(suite, n)
+	H := BaseForCommittee(suite, X)
+	require.Nil(test, VerifyBase(suite, X, H))
+
+	// A base point of the dealer's choice, e.g. one it knows the discrete
+	// logarithm of
+	forged := suite.Point().Mul(suite.Scalar().Pick(random.Stream), nil)
+	require.Equal(test, errorBase, VerifyBase(suite, X, forged))
+
+	// The genuine base point of another committee
+	require.Equal(test, errorBase, VerifyBase(suite, X[1:], H))
+}
+
+// countingSuite counts the goroutines concurrently using the suite.
+type countingSuite struct {
+	Suite
+	mu        sync.Mutex
+	active    int
+	maxActive int
+}
+
+func (s *countingSuite) Point() kyber.Point {
+	s.mu.Lock()
+	s.active++
+	if s.active > s.maxActive {
+		s.maxActive = s.active
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.active--
+		s.mu.Unlock()
+	}()
+	return s.Suite.Point()
+}
+
+func TestPVSSVerifyEncShareBatchWorkers(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Equal(test, err, nil)
+	sH := shareCommits(pubPoly, encShares)
+
+	// Corrupt some of the encrypted shares
+	encShares[2].S.V = suite.Point().Null()
+	encShares[7].S.V = suite.Point().Null()
+
+	K, E, err := VerifyEncShareBatch(suite, H, X, sH, encShares)
+	require.Equal(test, err, nil)
+	require.Equal(test, n-2, len(E))
+
+	for _, workers := range []int{1, 2, 3, n, 2 * n} {
+		cs := &countingSuite{Suite: suite}
+		KW, EW, err := VerifyEncShareBatchWorkers(cs, H, X, sH, encShares, workers)
+		require.Equal(test, err, nil)
+		require.Equal(test, K, KW)
+		require.Equal(test, E, EW)
+		require.True(test, cs.maxActive >= 1 && cs.maxActive <= workers)
+	}
+
+	_, _, err = VerifyEncShareBatchWorkers(suite, H, X, sH, encShares, 0)
+	require.Equal(test, errorWorkers, err)
+}
+
+func TestPVSSVerifyEncShareBatchEmpty(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+
+	K, E, err := VerifyEncShareBatch(suite, H, nil, nil, nil)
+	require.Equal(test, ErrEmptyInput, err)
+	require.Nil(test, K)
+	require.Nil(test, E)
+
+	_, _, err = VerifyEncShareBatch(suite, H, []kyber.Point{}, []kyber.Point{}, []*PubVerShare{})
+	require.Equal(test, ErrEmptyInput, err)
+
+	_, _, err = VerifyEncShareBatchWorkers(suite, H, nil, nil, nil, 2)
+	require.Equal(test, ErrEmptyInput, err)
+}
+
+func TestPVSSVerifySample(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 20
+	_, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 2*n/3+1)
+	require.Nil(test, err)
+	sH := shareCommits(pubPoly, encShares)
+	bad := 7
+	encShares[bad].S.V = suite.Point().Pick(random.Stream)
+
+	m := n / 2
+	caught := 0
+	for r := 0; r < 40; r++ {
+		sample, valid, err := VerifySample(suite, H, X, sH, encShares, m, random.Stream)
+		require.Nil(test, err)
+		require.Equal(test, m, len(sample))
+		require.Equal(test, m, len(valid))
+		for k, i := range sample {
+			if k > 0 {
+				require.True(test, sample[k-1] < i)
+			}
+			// the corrupted share is caught whenever it is sampled
+			require.Equal(test, i != bad, valid[k])
+			if i == bad {
+				caught++
+			}
+		}
+	}
+	// caught with probability 1/2 in each round
+	require.True(test, caught > 0 && caught < 40)
+
+	// the full sample is a complete verification
+	sample, valid, err := VerifySample(suite, H, X, sH, encShares, n, random.Stream)
+	require.Nil(test, err)
+	require.Equal(test, n, len(sample))
+	require.False(test, valid[bad])
+
+	// the sample only depends on the stream
+	s1, _, err := VerifySample(suite, H, X, sH, encShares, 5, suite.Cipher([]byte("seed")))
+	require.Nil(test, err)
+	s2, _, err := VerifySample(suite, H, X, sH, encShares, 5, suite.Cipher([]byte("seed")))
+	require.Nil(test, err)
+	require.Equal(test, s1, s2)
+
+	for _, size := range []int{0, -1, n + 1} {
+		_, _, err = VerifySample(suite, H, X, sH, encShares, size, random.Stream)
+		require.Equal(test, errorSampleSize, err)
+	}
+	_, _, err = VerifySample(suite, H, X[1:], sH, encShares, m, random.Stream)
+	require.Equal(test, errorDifferentLengths, err)
+	_, _, err = VerifySample(suite, H, nil, nil, nil, 1, random.Stream)
+	require.Equal(test, ErrEmptyInput, err)
+}
+
+func TestPVSSComplaint(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Equal(test, err, nil)
+	sH := shareCommits(pubPoly, encShares)
+
+	// No complaint can be issued against a valid share
+	_, err = NewComplaint(suite, H, X[0], sH[0], encShares[0])
+	require.Equal(test, errorValidShare, err)
+
+	// Justified complaint against a corrupted share the dealer published
+	bad := &PubVerShare{share.PubShare{I: encShares[1].S.I, V: suite.Point().Null()}, encShares[1].P}
+	dealt := append([]*PubVerShare{}, encShares...)
+	dealt[1] = bad
+	c, err := NewComplaint(suite, H, X[1], sH[1], bad)
+	require.Equal(test, err, nil)
+	require.Equal(test, errorEncVerification.Error(), c.Reason)
+	require.Nil(test, VerifyComplaint(suite, H, X, dealt, pubPoly, c))
+
+	// Unjustified complaint against a valid share
+	c.EncShare = encShares[2]
+	c.X = X[2]
+	c.SH = sH[2]
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, dealt, pubPoly, c))
+
+	// Unjustified complaint using a wrong commitment
+	c, err = NewComplaint(suite, H, X[2], sH[3], encShares[2])
+	require.Equal(test, err, nil)
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, dealt, pubPoly, c))
+
+	// Forged complaint against an honest deal: the incriminated share was
+	// never published
+	c, err = NewComplaint(suite, H, X[1], sH[1], bad)
+	require.Equal(test, err, nil)
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, encShares, pubPoly, c))
+
+	// Forged complaint for a key outside the committee: the published share
+	// does not verify against it
+	outsider := suite.Point().Mul(suite.Scalar().Pick(random.Stream), nil)
+	c, err = NewComplaint(suite, H, outsider, sH[1], encShares[1])
+	require.Equal(test, err, nil)
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, encShares, pubPoly, c))
+
+	// or for the key of another trustee
+	c, err = NewComplaint(suite, H, X[2], sH[1], encShares[1])
+	require.Equal(test, err, nil)
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, encShares, pubPoly, c))
+
+	// Malformed complaint
+	c.EncShare = &PubVerShare{S: share.PubShare{I: encShares[1].S.I}}
+	require.Equal(test, errorComplaint, VerifyComplaint(suite, H, X, encShares, pubPoly, c))
+}
+
+func TestPVSSVerifyEncShareWithPolyHash(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Equal(test, err, nil)
+
+	// Only the hash of the polynomial is posted
+	posted := pubPoly.Hash(suite)
+	for i := 0; i < n; i++ {
+		require.Nil(test, VerifyEncShareWithPolyHash(suite, H, X[i], pubPoly, posted, encShares[i]))
+	}
+
+	// A different polynomial does not match the posted hash
+	_, otherPoly, err := EncShares(suite, H, X, secret, t)
+	require.Equal(test, err, nil)
+	err = VerifyEncShareWithPolyHash(suite, H, X[0], otherPoly, posted, encShares[0])
+	require.Equal(test, errorPolyHash, err)
+
+	// A mismatched hash is rejected
+	wrong := append([]byte{}, posted...)
+	wrong[0] ^= 0xff
+	err = VerifyEncShareWithPolyHash(suite, H, X[0], pubPoly, wrong, encShares[0])
+	require.Equal(test, errorPolyHash, err)
+}
+
+func TestPVSSVerifyEncShareHashed(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 4
+	_, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 3)
+	require.Nil(test, err)
+
+	// The verifier only stores the hash of each commitment
+	for i := 0; i < n; i++ {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		stored := HashCommitment(suite, sH)
+		require.Nil(test, VerifyEncShareHashed(suite, H, X[i], sH, stored, encShares[i]))
+
+		// Another commitment doesn't match the stored hash
+		other := pubPoly.Eval((encShares[i].S.I + 1) % n).V
+		err = VerifyEncShareHashed(suite, H, X[i], other, stored, encShares[i])
+		require.Equal(test, errorCommitHash, err)
+	}
+
+	// A matching hash doesn't make an invalid share valid
+	sH := pubPoly.Eval(encShares[0].S.I).V
+	err = VerifyEncShareHashed(suite, H, X[1], sH, HashCommitment(suite, sH), encShares[0])
+	require.Equal(test, errorEncVerification, err)
+}
+
+func TestPVSSCommitmentShare(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	_, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 3)
+	require.Nil(test, err)
+
+	sH := make([]kyber.Point, n)
+	for i, s := range pubPoly.Shares(n) {
+		sH[i] = s.V
+	}
+	for i := 0; i < n; i++ {
+		c := CommitmentShare(pubPoly, encShares[i].S.I)
+		require.True(test, sH[i].Equal(c))
+		require.Nil(test, VerifyEncShare(suite, H, X[i], c, encShares[i]))
+	}
+}
+
+func TestPVSSDecShareMulti(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 6
+	t := 4
+	x, X := newCommittee(suite, n)
+	// trustee 0 holds the first three share indices
+	x[1], X[1] = x[0], X[0]
+	x[2], X[2] = x[0], X[0]
+
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.Nil(test, err)
+	sH := shareCommits(pubPoly, encShares)
+
+	E, D, err := DecShareMulti(suite, H, X[0], sH[:3], x[0], encShares[:3])
+	require.Nil(test, err)
+	require.Equal(test, 3, len(E))
+	require.Equal(test, 3, len(D))
+	for i := 0; i < 3; i++ {
+		require.Equal(test, encShares[i].S.I, D[i].S.I)
+		require.Nil(test, VerifyDecShare(suite, G, X[0], E[i], D[i]))
+	}
+
+	// together with one other trustee, the secret can be recovered
+	ds, err := DecShare(suite, H, X[3], sH[3], x[3], encShares[3])
+	require.Nil(test, err)
+	K := []kyber.Point{X[0], X[0], X[0], X[3]}
+	recovered, err := RecoverSecret(suite, G, K, append(E, encShares[3]), append(D, ds), t, n)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+
+	// invalid shares are skipped
+	bad := *encShares[1]
+	bad.S.V = suite.Point().Null()
+	E, D, err = DecShareMulti(suite, H, X[0], sH[:3], x[0], []*PubVerShare{encShares[0], &bad, encShares[2]})
+	require.Nil(test, err)
+	require.Equal(test, 2, len(D))
+	require.Equal(test, encShares[0].S.I, D[0].S.I)
+	require.Equal(test, encShares[2].S.I, D[1].S.I)
+
+	_, _, err = DecShareMulti(suite, H, X[0], sH[:2], x[0], encShares[:3])
+	require.Equal(test, errorDifferentLengths, err)
+}
+
+func TestPVSSVerifyDecShareBatchDetailed(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	t := 3
+	x, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), t)
+	require.Nil(test, err)
+
+	decShares := make([]*PubVerShare, n)
+	for i := 0; i < n; i++ {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		decShares[i], err = DecShare(suite, H, X[i], sH, x[i], encShares[i])
+		require.Nil(test, err)
+	}
+
+	// trustee 3 releases a wrong decryption
+	bad := 3
+	decShares[bad].S.V = suite.Point().Pick(random.Stream)
+
+	valid, errs, err := VerifyDecShareBatchDetailed(suite, G, X, encShares, decShares)
+	require.Nil(test, err)
+	require.Equal(test, n, len(valid))
+	require.Equal(test, n, len(errs))
+	for i := 0; i < n; i++ {
+		if i == bad {
+			require.False(test, valid[i])
+			require.Equal(test, errorDecVerification, errs[i])
+		} else {
+			require.True(test, valid[i])
+			require.Nil(test, errs[i])
+		}
+	}
+
+	_, _, err = VerifyDecShareBatchDetailed(suite, G, X[1:], encShares, decShares)
+	require.Equal(test, errorDifferentLengths, err)
+}
+
+func TestPVSSDecShareSuiteMismatch(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 3
+	x, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 2)
+	require.Nil(test, err)
+	sH := pubPoly.Eval(encShares[0].S.I).V
+
+	_, err = DecShare(suite, H, X[0], sH, x[0], encShares[0])
+	require.Nil(test, err)
+
+	// a private key modulo another prime
+	other := mod.NewInt64(42, big.NewInt(65537))
+	_, err = DecShare(suite, H, X[0], sH, other, encShares[0])
+	require.Equal(test, ErrSuiteMismatch, err)
+}
+
+func TestPVSSValidateThreshold(test *testing.T) {
+	for _, c := range []struct{ t, n int }{{1, 1}, {2, 3}, {3, 4}, {6, 10}, {1, 10}} {
+		warn, err := ValidateThreshold(c.t, c.n)
+		require.Nil(test, err, "t=%d n=%d", c.t, c.n)
+		require.False(test, warn, "t=%d n=%d", c.t, c.n)
+	}
+	for _, c := range []struct{ t, n int }{{4, 3}, {0, 3}, {-1, 3}, {1, 0}, {11, 10}} {
+		_, err := ValidateThreshold(c.t, c.n)
+		require.NotNil(test, err, "t=%d n=%d", c.t, c.n)
+		terr, ok := err.(*ThresholdError)
+		require.True(test, ok)
+		require.Equal(test, c.t, terr.T)
+		require.Equal(test, c.n, terr.N)
+	}
+	// working configurations, such as the usual t = 2n/3+1, only warn
+	for _, c := range []struct{ t, n int }{{3, 3}, {7, 10}, {10, 10}} {
+		warn, err := ValidateThreshold(c.t, c.n)
+		require.Nil(test, err, "t=%d n=%d", c.t, c.n)
+		require.True(test, warn, "t=%d n=%d", c.t, c.n)
+	}
+}
+
+func TestPVSSRecoverSecretParanoid(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	x, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, _, err := EncShares(suite, H, X, secret, t)
+	require.Nil(test, err)
+
+	decrypt := func(encShares []*PubVerShare) []*PubVerShare {
+		D := make([]*PubVerShare, n)
+		for i := 0; i < n; i++ {
+			D[i], err = decShare(suite, x[i], suite.Scalar().Inv(x[i]), encShares[i])
+			require.Nil(test, err)
+		}
+		return D
+	}
+
+	D := decrypt(encShares)
+	recovered, err := RecoverSecretParanoid(suite, G, X, encShares, D, t, n)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+
+	// no other subset to cross-check with
+	_, err = RecoverSecretParanoid(suite, G, X[:t], encShares[:t], D[:t], t, n)
+	require.Equal(test, errorParanoidShares, err)
+
+	// The dealer encrypts a share off the polynomial, with a valid proof:
+	// it decrypts correctly, so only the cross-check catches it, whether the
+	// share is in the first t, in the last t or in both.
+	for _, i := range []int{0, n / 2, n - 1} {
+		r := suite.Scalar().Pick(random.Stream)
+		P, _, _, err := dleq.NewDLEQProof(suite, H, X[i], r)
+		require.Nil(test, err)
+		bad := append([]*PubVerShare{}, encShares...)
+		bad[i] = &PubVerShare{share.PubShare{I: i, V: suite.Point().Mul(r, X[i])}, *P}
+		D = decrypt(bad)
+		_, err = RecoverSecret(suite, G, X, bad, D, t, n)
+		require.Nil(test, err)
+		_, err = RecoverSecretParanoid(suite, G, X, bad, D, t, n)
+		require.NotNil(test, err, "bad share %d", i)
+	}
+}
+
+func TestPVSSRecoverSecretStrict(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	x, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+
+	distribute := func(priPoly *share.PriPoly) ([]*PubVerShare, *share.PubPoly, []*PubVerShare) {
+		encShares, err := encryptShares(suite, H, X, priPoly.Shares(n))
+		require.Nil(test, err)
+		D := make([]*PubVerShare, n)
+		for i := 0; i < n; i++ {
+			D[i], err = decShare(suite, x[i], suite.Scalar().Inv(x[i]), encShares[i])
+			require.Nil(test, err)
+		}
+		return encShares, priPoly.Commit(H), D
+	}
+
+	E, pubPoly, D := distribute(share.NewPriPoly(suite, t, secret, random.Stream))
+	recovered, err := RecoverSecretStrict(suite, G, X, pubPoly, E, D, t, n)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+
+	// The dealer zeroes the leading coefficient: t-1 shares are enough.
+	E, pubPoly, D = distribute(share.NewPriPoly(suite, t-1, secret, random.Stream))
+	_, commits := pubPoly.Info()
+	pubPoly = share.NewPubPoly(suite, H, append(commits, suite.Point().Null()))
+	recovered, err = RecoverSecret(suite, G, X[:t-1], E[:t-1], D[:t-1], t-1, n)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+	_, err = RecoverSecretStrict(suite, G, X, pubPoly, E, D, t, n)
+	require.Equal(test, errorDegenerate, err)
+}
+
+func TestPVSSVerifyDecShareOwner(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 3
+	x, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 2)
+	require.Nil(test, err)
+
+	ds, err := DecShare(suite, H, X[0], pubPoly.Eval(0).V, x[0], encShares[0])
+	require.Nil(test, err)
+	require.Nil(test, VerifyDecShareOwner(suite, G, X[0], encShares[0], ds))
+
+	// the share is attributed to the wrong trustee
+	require.Equal(test, errorDecIndex, VerifyDecShareOwner(suite, G, X[1], encShares[1], ds))
+
+	// the share is relabeled with the index of another trustee, which the
+	// proof checked by VerifyDecShare does not cover
+	relabeled := &PubVerShare{share.PubShare{I: 1, V: ds.S.V}, ds.P}
+	require.Nil(test, VerifyDecShare(suite, G, X[0], encShares[0], relabeled))
+	require.Equal(test, errorDecIndex, VerifyDecShareOwner(suite, G, X[0], encShares[0], relabeled))
+	require.Equal(test, errorDecVerification, VerifyDecShareOwner(suite, G, X[1], encShares[1], relabeled))
+
+	// so is a decrypted share of any value with a forged proof
+	forged := forgeDecShare(suite, G, X[0], encShares[0])
+	require.Nil(test, VerifyDecShare(suite, G, X[0], encShares[0], forged))
+	require.Equal(test, errorDecVerification, VerifyDecShareOwner(suite, G, X[0], encShares[0], forged))
+}
+
+// forgeDecShare returns a decrypted share of encShare with a random value,
+// whose proof satisfies the equations checked by dleq.Proof.Verify thanks to
+// a random challenge and response.
+func forgeDecShare(suite Suite, G kyber.Point, X kyber.Point, encShare *PubVerShare) *PubVerShare {
+	S := suite.Point().Pick(random.Stream)
+	c := suite.Scalar().Pick(random.Stream)
+	r := suite.Scalar().Pick(random.Stream)
+	return &PubVerShare{share.PubShare{I: encShare.S.I, V: S}, dleq.Proof{
+		C:  c,
+		R:  r,
+		VG: suite.Point().Add(suite.Point().Mul(r, G), suite.Point().Mul(c, X)),
+		VH: suite.Point().Add(suite.Point().Mul(r, S), suite.Point().Mul(c, encShare.S.V)),
+	}}
+}
+
+func TestPVSSAddTrustees(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n, m := 5, 3
+	t := 4
+	x, X := newCommittee(suite, n+m)
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, priShares, err := EncSharesWithPrivate(suite, H, X[:n], secret, t)
+	require.Nil(test, err)
+	priPoly, err := share.RecoverPriPoly(suite, priShares, t, n)
+	require.Nil(test, err)
+
+	newShares, err := AddTrustees(suite, H, priPoly, X[n:], n)
+	require.Nil(test, err)
+	require.Equal(test, m, len(newShares))
+	encShares = append(encShares, newShares...)
+
+	// two former and two new trustees reconstruct together
+	var K []kyber.Point
+	var E, D []*PubVerShare
+	for _, i := range []int{0, 3, 5, 7} {
+		require.Equal(test, i, encShares[i].S.I)
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		ds, err := DecShare(suite, H, X[i], sH, x[i], encShares[i])
+		require.Nil(test, err)
+		K = append(K, X[i])
+		E = append(E, encShares[i])
+		D = append(D, ds)
+	}
+	recovered, err := RecoverSecret(suite, G, K, E, D, t, n+m)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+
+	_, err = AddTrustees(suite, H, priPoly, X[n:], -1)
+	require.Equal(test, errorStartIndex, err)
+}
+
+func TestPVSSVerifySharedSecret(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	_, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+	S := suite.Point().Mul(secret, H) // published in advance
+	_, pubPoly, err := EncShares(suite, H, X, secret, 3)
+	require.Nil(test, err)
+
+	require.Nil(test, VerifySharedSecret(suite, H, pubPoly, S))
+	other := suite.Point().Mul(suite.Scalar().Pick(random.Stream), H)
+	require.Equal(test, errorSharedSecret, VerifySharedSecret(suite, H, pubPoly, other))
+	// the commitment is relative to H, not to the standard base point
+	require.Equal(test, errorSharedSecret, VerifySharedSecret(suite, H, pubPoly, suite.Point().Mul(secret, nil)))
+	require.Equal(test, errorPolyBase, VerifySharedSecret(suite, suite.Point().Base(), pubPoly, S))
+}
+
+func TestPVSSAuditDecryption(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 7
+	t := 3
+	x, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), t)
+	require.Nil(test, err)
+
+	decShares := make([]*PubVerShare, n)
+	for i := 0; i < n; i++ {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		decShares[i], err = DecShare(suite, H, X[i], sH, x[i], encShares[i])
+		require.Nil(test, err)
+	}
+
+	// trustee 1 releases a wrong decryption, trustee 2 relabels its share,
+	// trustee 4 does not answer and trustee 6 forges the proof of its share
+	decShares[1].S.V = suite.Point().Pick(random.Stream)
+	decShares[2].S.I = 5
+	decShares[4] = nil
+	decShares[6] = forgeDecShare(suite, G, X[6], encShares[6])
+	expected := []error{nil, errorDecVerification, errorDecIndex, nil, errorMissingShare, nil, errorDecVerification}
+
+	report, err := AuditDecryption(suite, G, X, encShares, decShares, t)
+	require.Nil(test, err)
+	require.Equal(test, expected, report.Errors)
+	for i := range expected {
+		require.Equal(test, expected[i] == nil, report.Valid[i])
+	}
+	require.Equal(test, 3, report.ValidCount)
+	require.Equal(test, t, report.Threshold)
+	require.True(test, report.ThresholdMet)
+
+	report, err = AuditDecryption(suite, G, X, encShares, decShares, t+1)
+	require.Nil(test, err)
+	require.False(test, report.ThresholdMet)
+
+	_, err = AuditDecryption(suite, G, X[1:], encShares, decShares, t)
+	require.Equal(test, errorDifferentLengths, err)
+}
+
+func TestPVSSVerifyShareIndices(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	_, X := newCommittee(suite, n)
+	priPoly := share.NewPriPoly(suite, 3, nil, random.Stream)
+	pubPoly := priPoly.Commit(H)
+
+	encShares, err := encryptShares(suite, H, X, priPoly.Shares(n))
+	require.Nil(test, err)
+	require.Nil(test, VerifyShareIndices(encShares, 0))
+
+	newX := []kyber.Point{suite.Point().Pick(random.Stream)}
+	added, err := AddTrustees(suite, H, priPoly, newX, n)
+	require.Nil(test, err)
+	require.Nil(test, VerifyShareIndices(added, n))
+	require.Nil(test, VerifyShareIndices(append(encShares, added...), 0))
+
+	// The shares 1 and 2 are encrypted to each other's trustee: each of
+	// them verifies, but the trustees hold the wrong indices.
+	priShares := priPoly.Shares(n)
+	priShares[1], priShares[2] = priShares[2], priShares[1]
+	swapped, err := encryptShares(suite, H, X, priShares)
+	require.Nil(test, err)
+	K, _, err := VerifyEncShareBatch(suite, H, X, shareCommits(pubPoly, swapped), swapped)
+	require.Nil(test, err)
+	require.Equal(test, n, len(K))
+	err = VerifyShareIndices(swapped, 0)
+	require.NotNil(test, err)
+	require.Equal(test, "share at position 1 has index 2 instead of 1", err.Error())
+}
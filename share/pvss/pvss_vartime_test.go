@@ -0,0 +1,32 @@
+// +build vartime
+
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSDecShareOtherGroup(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	p256 := nist.NewAES128SHA256P256()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	x := suite.Scalar().Pick(random.Stream)
+	X := suite.Point().Mul(x, nil)
+	encShares, pubPoly, err := EncShares(suite, H, []kyber.Point{X}, suite.Scalar().Pick(random.Stream), 1)
+	require.Nil(test, err)
+	sH := pubPoly.Eval(encShares[0].S.I).V
+
+	// the public key of the trustee comes from the nist suite
+	_, err = DecShare(suite, H, p256.Point().Mul(p256.Scalar().Pick(random.Stream), nil), sH, x, encShares[0])
+	require.Equal(test, ErrSuiteMismatch, err)
+
+	// and so does the whole share, verified with the edwards25519 suite
+	_, err = DecShare(p256, H, X, sH, x, encShares[0])
+	require.Equal(test, ErrSuiteMismatch, err)
+}
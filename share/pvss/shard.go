@@ -0,0 +1,88 @@
+package pvss
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errorShardEmbed = errors.New("group can't embed data into points")
+
+// Shard is one of the PVSS instances sharing a secret larger than a scalar.
+// The instance shares a random scalar s, and C = M + sG blinds the piece of
+// the secret embedded in the point M, so that the piece is revealed once sG is
+// recovered.
+type Shard struct {
+	EncShares []*PubVerShare
+	PubPoly   *share.PubPoly
+	C         kyber.Point
+}
+
+// EncSharesSharded shares a byte secret of any length among the trustees of
+// public keys X with threshold t. The secret is cut into as many pieces as
+// needed to embed each into a point, and each piece is shared with its own
+// PVSS instance using EncShares with the same committee, base point H and
+// threshold. The trustees decrypt their share of each instance as usual.
+func EncSharesSharded(suite Suite, H kyber.Point, X []kyber.Point, secret []byte, t int) ([]*Shard, error) {
+	size := suite.Point().EmbedLen()
+	if size <= 0 {
+		return nil, errorShardEmbed
+	}
+	var shards []*Shard
+	for len(secret) > 0 {
+		piece := secret
+		if len(piece) > size {
+			piece = piece[:size]
+		}
+		secret = secret[len(piece):]
+
+		s := suite.Scalar().Pick(random.Stream)
+		encShares, pubPoly, err := EncShares(suite, H, X, s, t)
+		if err != nil {
+			return nil, err
+		}
+		M := suite.Point().Embed(piece, random.Stream)
+		C := suite.Point().Mul(s, nil)
+		shards = append(shards, &Shard{encShares, pubPoly, C.Add(C, M)})
+	}
+	return shards, nil
+}
+
+// RecoverSecretSharded recovers a byte secret shared with EncSharesSharded.
+// decShares[k][i] is the decrypted share of shards[k] of the trustee with
+// public key X[i], or nil if it is missing. Each instance is recovered with
+// RecoverSecret, so that at least t valid decrypted shares are needed for
+// every shard.
+func RecoverSecretSharded(suite Suite, G kyber.Point, X []kyber.Point, shards []*Shard, decShares [][]*PubVerShare, t int, n int) ([]byte, error) {
+	if len(shards) != len(decShares) {
+		return nil, errorDifferentLengths
+	}
+	var secret []byte
+	for k, shard := range shards {
+		if len(shard.EncShares) != len(X) || len(decShares[k]) != len(X) {
+			return nil, errorDifferentLengths
+		}
+		var K []kyber.Point
+		var E []*PubVerShare
+		var D []*PubVerShare
+		for i, ds := range decShares[k] {
+			if ds != nil {
+				K = append(K, X[i])
+				E = append(E, shard.EncShares[i])
+				D = append(D, ds)
+			}
+		}
+		sG, err := RecoverSecret(suite, G, K, E, D, t, n)
+		if err != nil {
+			return nil, err
+		}
+		piece, err := suite.Point().Sub(shard.C, sG).Data()
+		if err != nil {
+			return nil, err
+		}
+		secret = append(secret, piece...)
+	}
+	return secret, nil
+}
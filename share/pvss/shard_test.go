@@ -0,0 +1,47 @@
+package pvss
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSSharded(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	n := 5
+	t := 3
+	x, X := newCommittee(suite, n)
+	secret := random.Bytes(128, random.Stream)
+
+	shards, err := EncSharesSharded(suite, H, X, secret, t)
+	require.Nil(test, err)
+	size := suite.Point().EmbedLen()
+	require.Equal(test, (len(secret)+size-1)/size, len(shards))
+
+	// Only the last t trustees decrypt their shares
+	decShares := make([][]*PubVerShare, len(shards))
+	for k, shard := range shards {
+		decShares[k] = make([]*PubVerShare, n)
+		for i := n - t; i < n; i++ {
+			sH := shard.PubPoly.Eval(shard.EncShares[i].S.I).V
+			ds, err := DecShare(suite, H, X[i], sH, x[i], shard.EncShares[i])
+			require.Nil(test, err)
+			decShares[k][i] = ds
+		}
+	}
+	recovered, err := RecoverSecretSharded(suite, G, X, shards, decShares, t, n)
+	require.Nil(test, err)
+	require.Equal(test, secret, recovered)
+
+	// Every shard needs t decrypted shares
+	decShares[1][n-1] = nil
+	_, err = RecoverSecretSharded(suite, G, X, shards, decShares, t, n)
+	require.Equal(test, errorTooFewShares, err)
+
+	_, err = RecoverSecretSharded(suite, G, X, shards[1:], decShares, t, n)
+	require.Equal(test, errorDifferentLengths, err)
+}
@@ -0,0 +1,148 @@
+package pvss
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/subtle"
+)
+
+// streamChunkSize is the maximum plaintext length of a frame of an encrypted
+// stream.
+const streamChunkSize = 64 * 1024
+
+// streamFinal flags the last frame of an encrypted stream in its header.
+const streamFinal = 1 << 31
+
+// streamNonceSize is the length of the random nonce heading an encrypted
+// stream.
+const streamNonceSize = 16
+
+var errorStreamFormat = errors.New("malformed or truncated encrypted stream")
+var errorStreamAuth = errors.New("ciphertext authentication failed")
+
+// SecretToKey derives symmetric key material from a secret recovered with
+// RecoverSecret.
+func SecretToKey(suite Suite, secret kyber.Point) ([]byte, error) {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("pvss-secret-key"))
+	if _, err := secret.MarshalTo(h); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// EncryptStream encrypts everything read from r with the key derived from the
+// secret and writes it to w, without buffering more than a frame. The stream
+// starts with a random 16-byte nonce, which is mixed into the key so that the
+// streams encrypted with the same secret do not share their keystream. It is
+// then cut into frames of at most 64KiB, each made of a 32-bit big-endian
+// header with the frame length and a flag on the last frame, then the
+// ciphertext sealed with the suite's cipher. The cipher state carries over
+// from frame to frame and absorbs the headers, so that frames can't be
+// reordered, dropped or truncated.
+func EncryptStream(suite Suite, secret kyber.Point, r io.Reader, w io.Writer) error {
+	nonce := random.Bytes(streamNonceSize, random.Stream)
+	c, err := streamCipher(suite, secret, nonce)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(nonce); err != nil {
+		return err
+	}
+	buf := make([]byte, streamChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		final := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !final {
+			return err
+		}
+		header := make([]byte, 4)
+		h := uint32(n)
+		if final {
+			h |= streamFinal
+		}
+		binary.BigEndian.PutUint32(header, h)
+		c.Message(nil, nil, header)
+		if _, err := w.Write(c.Seal(header, buf[:n])); err != nil {
+			return err
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// DecryptStream decrypts a stream encrypted with EncryptStream, reading from r
+// and writing the plaintext to w frame by frame. Each frame is authenticated
+// before being written, but if an error is returned, the frames written so far
+// must be discarded: the stream was truncated or tampered with.
+func DecryptStream(suite Suite, secret kyber.Point, r io.Reader, w io.Writer) error {
+	nonce := make([]byte, streamNonceSize)
+	if _, err := io.ReadFull(r, nonce); err != nil {
+		return errorStreamFormat
+	}
+	c, err := streamCipher(suite, secret, nonce)
+	if err != nil {
+		return err
+	}
+	header := make([]byte, 4)
+	buf := make([]byte, streamChunkSize+c.KeySize())
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return errorStreamFormat
+		}
+		h := binary.BigEndian.Uint32(header)
+		n := int(h &^ streamFinal)
+		if n > streamChunkSize {
+			return errorStreamFormat
+		}
+		sealed := buf[:n+c.KeySize()]
+		if _, err := io.ReadFull(r, sealed); err != nil {
+			return errorStreamFormat
+		}
+		c.Message(nil, nil, header)
+		msg, err := openFrame(c, sealed)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(msg); err != nil {
+			return err
+		}
+		if h&streamFinal != 0 {
+			if _, err := io.ReadFull(r, header[:1]); err != io.EOF {
+				return errorStreamFormat
+			}
+			return nil
+		}
+	}
+}
+
+// openFrame decrypts and authenticates a frame sealed with c.Seal. Open can't
+// take an empty message, which the final frame is when the length of the
+// stream is a multiple of streamChunkSize, so openFrame checks the MAC of an
+// empty frame itself, absorbing the same empty message as Seal did.
+func openFrame(c kyber.Cipher, sealed []byte) ([]byte, error) {
+	if len(sealed) > c.KeySize() {
+		return c.Open(nil, sealed)
+	}
+	c.Message(nil, nil, nil)
+	c.Message(sealed, sealed, nil) // Compute MAC and XOR with received
+	if subtle.ConstantTimeAllEq(sealed, 0) == 0 {
+		return nil, errorStreamAuth
+	}
+	return nil, nil
+}
+
+// streamCipher returns the cipher of a stream, keyed with the key derived from
+// the secret and the nonce of the stream.
+func streamCipher(suite Suite, secret kyber.Point, nonce []byte) (kyber.Cipher, error) {
+	key, err := SecretToKey(suite, secret)
+	if err != nil {
+		return kyber.Cipher{}, err
+	}
+	return suite.Cipher(append(key, nonce...)), nil
+}
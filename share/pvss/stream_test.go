@@ -0,0 +1,57 @@
+package pvss
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSStream(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	secret := suite.Point().Pick(random.Stream)
+
+	for _, size := range []int{0, 1000, streamChunkSize, 3<<20 + 17} {
+		msg := random.Bytes(size, random.Stream)
+		var enc bytes.Buffer
+		require.Nil(test, EncryptStream(suite, secret, bytes.NewReader(msg), &enc))
+		ctx := enc.Bytes()
+
+		var dec bytes.Buffer
+		require.Nil(test, DecryptStream(suite, secret, bytes.NewReader(ctx), &dec))
+		require.Equal(test, msg, dec.Bytes())
+
+		// wrong secret
+		other := suite.Point().Pick(random.Stream)
+		require.Error(test, DecryptStream(suite, other, bytes.NewReader(ctx), &bytes.Buffer{}))
+
+		// truncated, extended and tampered streams
+		require.Error(test, DecryptStream(suite, secret, bytes.NewReader(ctx[:len(ctx)-1]), &bytes.Buffer{}))
+		require.Error(test, DecryptStream(suite, secret, bytes.NewReader(append(ctx, 0)), &bytes.Buffer{}))
+		tampered := append([]byte{}, ctx...)
+		tampered[len(tampered)/2] ^= 1
+		require.Error(test, DecryptStream(suite, secret, bytes.NewReader(tampered), &bytes.Buffer{}))
+		tampered = append([]byte{}, ctx...)
+		tampered[0] ^= 1
+		require.Error(test, DecryptStream(suite, secret, bytes.NewReader(tampered), &bytes.Buffer{}))
+		require.Error(test, DecryptStream(suite, secret, bytes.NewReader(ctx[:streamNonceSize-1]), &bytes.Buffer{}))
+	}
+}
+
+func TestPVSSStreamNonce(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	secret := suite.Point().Pick(random.Stream)
+	msg := make([]byte, 1000)
+
+	// the same plaintext encrypted twice under the same secret shares
+	// neither its nonce nor its keystream
+	var enc1, enc2 bytes.Buffer
+	require.Nil(test, EncryptStream(suite, secret, bytes.NewReader(msg), &enc1))
+	require.Nil(test, EncryptStream(suite, secret, bytes.NewReader(msg), &enc2))
+	ctx1, ctx2 := enc1.Bytes(), enc2.Bytes()
+	require.Equal(test, len(ctx1), len(ctx2))
+	require.NotEqual(test, ctx1[:streamNonceSize], ctx2[:streamNonceSize])
+	require.NotEqual(test, ctx1[streamNonceSize+4:], ctx2[streamNonceSize+4:])
+}
@@ -0,0 +1,263 @@
+package pvss
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/hash"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errorTranscriptFormat = errors.New("malformed or truncated transcript")
+
+// Transcript is the public output of a distribution: the base point H, the
+// public keys X of the trustees, the encrypted shares and the commitment
+// polynomial, all an auditor needs to check the distribution. The nonce H
+// was derived from is set by EncSharesWithNonce; if it is set,
+// VerifyTranscript checks that H was derived from it.
+//
+// Hash identifies the hash of the Fiat-Shamir challenges of the proofs, see
+// HashID. It is set by EncSharesWithNonce and EncryptToCommittee; if it is
+// set, VerifyTranscript rejects the transcript with ErrHashMismatch when the
+// verifier's suite hashes otherwise, without checking the proofs.
+type Transcript struct {
+	H         kyber.Point
+	X         []kyber.Point
+	EncShares []*PubVerShare
+	PubPoly   *share.PubPoly
+	Nonce     []byte
+	Hash      []byte
+}
+
+// HashID returns a short identifier of the hash of the suite, with which the
+// challenges of the proofs of the shares are derived: the first 8 bytes of
+// the hash of a fixed string. Two suites of the same group derive the same
+// challenges if their identifiers are equal, whatever their names.
+func HashID(suite Suite) []byte {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("pvss-hash-id"))
+	return h.Sum(nil)[:8]
+}
+
+// MarshalBinary encodes the transcript as H, the public keys and the
+// commitments of the polynomial, both encoded with kyber.MarshalPoints, and
+// the encrypted shares, each as its 32-bit big-endian index, the share and its
+// proof, followed by the nonce and the hash identifier, each prefixed with its
+// 32-bit big-endian length. Up to the nonce, this is the beginning of the
+// transcript of the test vector of the package.
+func (t *Transcript) MarshalBinary() ([]byte, error) {
+	if len(t.X) != len(t.EncShares) {
+		return nil, errorDifferentLengths
+	}
+	var b bytes.Buffer
+	if _, err := t.H.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	_, commits := t.PubPoly.Info()
+	for _, points := range [][]kyber.Point{t.X, commits} {
+		buf, err := kyber.MarshalPoints(points)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(buf)
+	}
+	for _, s := range t.EncShares {
+		if err := writePubVerShare(&b, s); err != nil {
+			return nil, err
+		}
+	}
+	writeBytes(&b, t.Nonce)
+	writeBytes(&b, t.Hash)
+	return b.Bytes(), nil
+}
+
+// UnmarshalTranscript decodes a transcript encoded with MarshalBinary. The
+// base point of the decoded polynomial is H.
+func UnmarshalTranscript(suite Suite, data []byte) (*Transcript, error) {
+	r := bytes.NewReader(data)
+	t := &Transcript{H: suite.Point()}
+	if _, err := t.H.UnmarshalFrom(r); err != nil {
+		return nil, errorTranscriptFormat
+	}
+	readPoints := func() ([]kyber.Point, error) {
+		var n uint32
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return nil, errorTranscriptFormat
+		}
+		size := uint64(n) * uint64(suite.PointLen())
+		if size > uint64(r.Len()) {
+			return nil, errorTranscriptFormat
+		}
+		buf := make([]byte, 4+size)
+		binary.BigEndian.PutUint32(buf, n)
+		_, _ = r.Read(buf[4:])
+		return kyber.UnmarshalPoints(suite, buf, int(n))
+	}
+	var err error
+	if t.X, err = readPoints(); err != nil {
+		return nil, err
+	}
+	commits, err := readPoints()
+	if err != nil {
+		return nil, err
+	}
+	t.PubPoly = share.NewPubPoly(suite, t.H, commits)
+	t.EncShares = make([]*PubVerShare, len(t.X))
+	for i := range t.EncShares {
+		s := &PubVerShare{}
+		var index uint32
+		if err := binary.Read(r, binary.BigEndian, &index); err != nil {
+			return nil, errorTranscriptFormat
+		}
+		s.S.I = int(index)
+		s.S.V = suite.Point()
+		s.P.C = suite.Scalar()
+		s.P.R = suite.Scalar()
+		s.P.VG = suite.Point()
+		s.P.VH = suite.Point()
+		for _, m := range []kyber.Marshaling{s.S.V, s.P.C, s.P.R, s.P.VG, s.P.VH} {
+			if _, err := m.UnmarshalFrom(r); err != nil {
+				return nil, errorTranscriptFormat
+			}
+		}
+		t.EncShares[i] = s
+	}
+	if t.Nonce, err = readBytes(r); err != nil {
+		return nil, err
+	}
+	if t.Hash, err = readBytes(r); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errorTranscriptFormat
+	}
+	return t, nil
+}
+
+// VerifyTranscript checks all the encrypted shares of the transcript at once.
+// The proofs of the shares made by EncShares share a collective challenge,
+// which is derived again from the commitments sH_i = p(i) of the shares, the
+// encrypted shares sX_i and the commitments VG_i and VH_i of the proofs. The
+// proofs (C_i, R_i, VG_i, VH_i) are then checked with a random linear
+// combination: with random scalars a_i and b_i, they are valid if
+//
+//	sum a_i (R_i H + C_i sH_i - VG_i) + b_i (R_i X_i + C_i sX_i - VH_i) == 0
+//
+// which fails with overwhelming probability if any proof is invalid. If the
+// check fails, the shares are verified one by one with VerifyEncShare and
+// against the collective challenge, and the errors are returned, aligned with
+// the shares, nil for the valid ones. Since the challenge covers all the
+// shares, a share altered after the distribution makes the others fail with
+// ErrBadChallenge. An error is returned if the transcript is malformed or
+// records a nonce H was not derived from, ErrHashMismatch if it records a hash
+// other than the one of the suite, and ErrBadChallenge if its proofs hold but
+// their challenge is not the collective challenge.
+func VerifyTranscript(suite Suite, t *Transcript) (bool, []error, error) {
+	n := len(t.EncShares)
+	if len(t.X) != n {
+		return false, nil, errorDifferentLengths
+	}
+	if len(t.Hash) > 0 && !bytes.Equal(t.Hash, HashID(suite)) {
+		return false, nil, ErrHashMismatch
+	}
+	if len(t.Nonce) > 0 {
+		if err := VerifyBaseNonce(suite, t.Nonce, t.H); err != nil {
+			return false, nil, err
+		}
+	}
+	sH := make([]kyber.Point, n)
+	for i, s := range t.EncShares {
+		sH[i] = t.PubPoly.Eval(s.S.I).V
+	}
+	c, err := transcriptChallenge(suite, sH, t.EncShares)
+	if err != nil {
+		return false, nil, err
+	}
+	bound := true
+	rand := random.Stream
+
+	sumRa := suite.Scalar().Zero() // sum a_i R_i, factor of H
+	acc := suite.Point().Null()
+	tmp := suite.Point()
+	ab := suite.Scalar()
+	for i, s := range t.EncShares {
+		if !s.P.C.Equal(c) {
+			bound = false
+		}
+		a := suite.Scalar().Pick(rand)
+		b := suite.Scalar().Pick(rand)
+		sumRa.Add(sumRa, ab.Mul(a, s.P.R))
+		acc.Add(acc, tmp.Mul(ab.Mul(a, s.P.C), sH[i]))
+		acc.Sub(acc, tmp.Mul(a, s.P.VG))
+		acc.Add(acc, tmp.Mul(ab.Mul(b, s.P.R), t.X[i]))
+		acc.Add(acc, tmp.Mul(ab.Mul(b, s.P.C), s.S.V))
+		acc.Sub(acc, tmp.Mul(b, s.P.VH))
+	}
+	acc.Add(acc, tmp.Mul(sumRa, t.H))
+	if acc.Equal(suite.Point().Null()) {
+		if !bound {
+			return false, nil, ErrBadChallenge
+		}
+		return true, nil, nil
+	}
+
+	errs := make([]error, n)
+	for i, s := range t.EncShares {
+		errs[i] = VerifyEncShare(suite, t.H, t.X[i], sH[i], s)
+		if errs[i] == nil && !s.P.C.Equal(c) {
+			errs[i] = ErrBadChallenge
+		}
+	}
+	return false, errs, nil
+}
+
+// transcriptChallenge derives the collective challenge of the proofs of the
+// shares as dleq.NewDLEQProofBatch does: hash.Scalar of the commitments sH,
+// the encrypted shares and the commitments VG and VH of the proofs, each list
+// in the order of the shares.
+func transcriptChallenge(suite Suite, sH []kyber.Point, shares []*PubVerShare) (kyber.Scalar, error) {
+	inputs := make([]hash.Framed, 0, 4*len(shares))
+	for _, p := range sH {
+		inputs = append(inputs, p)
+	}
+	for _, s := range shares {
+		inputs = append(inputs, s.S.V)
+	}
+	for _, s := range shares {
+		inputs = append(inputs, s.P.VG)
+	}
+	for _, s := range shares {
+		inputs = append(inputs, s.P.VH)
+	}
+	return hash.Scalar(suite, inputs...)
+}
+
+// writeBytes writes buf prefixed with its length as a 32-bit big-endian
+// integer.
+func writeBytes(b *bytes.Buffer, buf []byte) {
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(buf)))
+	b.Write(l[:])
+	b.Write(buf)
+}
+
+// readBytes reads a byte slice written by writeBytes. An empty slice is read
+// as nil.
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	var n uint32
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return nil, errorTranscriptFormat
+	}
+	if uint64(n) > uint64(r.Len()) {
+		return nil, errorTranscriptFormat
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	buf := make([]byte, n)
+	_, _ = r.Read(buf)
+	return buf, nil
+}
@@ -0,0 +1,255 @@
+package pvss
+
+import (
+	"crypto/sha512"
+	"hash"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func newTranscript(test testing.TB, suite Suite, n int) *Transcript {
+	H := suite.Point().Pick(suite.Cipher([]byte("H")))
+	_, X := newCommittee(suite, n)
+	encShares, pubPoly, err := EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 2*n/3+1)
+	require.Nil(test, err)
+	return &Transcript{H: H, X: X, EncShares: encShares, PubPoly: pubPoly}
+}
+
+func TestPVSSVerifyTranscript(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 10
+	tr := newTranscript(test, suite, n)
+
+	ok, errs, err := VerifyTranscript(suite, tr)
+	require.Nil(test, err)
+	require.True(test, ok)
+	require.Nil(test, errs)
+
+	// serialization round trip
+	buf, err := tr.MarshalBinary()
+	require.Nil(test, err)
+	dec, err := UnmarshalTranscript(suite, buf)
+	require.Nil(test, err)
+	require.True(test, dec.H.Equal(tr.H))
+	require.True(test, dec.PubPoly.Equal(tr.PubPoly))
+	ok, _, err = VerifyTranscript(suite, dec)
+	require.Nil(test, err)
+	require.True(test, ok)
+	buf2, err := dec.MarshalBinary()
+	require.Nil(test, err)
+	require.Equal(test, buf, buf2)
+	_, err = UnmarshalTranscript(suite, buf[:len(buf)-1])
+	require.Equal(test, errorTranscriptFormat, err)
+	_, err = UnmarshalTranscript(suite, append(buf, 0))
+	require.Equal(test, errorTranscriptFormat, err)
+
+	// a bad share is pinpointed by the fallback, and changes the challenge
+	// of the others
+	bad := *dec.EncShares[3]
+	bad.S.V = suite.Point().Add(bad.S.V, suite.Point().Base())
+	dec.EncShares[3] = &bad
+	ok, errs, err = VerifyTranscript(suite, dec)
+	require.Nil(test, err)
+	require.False(test, ok)
+	require.Equal(test, n, len(errs))
+	for i, e := range errs {
+		if i == 3 {
+			require.Equal(test, errorEncVerification, e)
+		} else {
+			require.Equal(test, ErrBadChallenge, e)
+		}
+	}
+
+	// proofs of random shares with a random challenge and responses satisfy
+	// the batch equation, but not the collective challenge
+	forged := &Transcript{H: tr.H, X: tr.X, EncShares: make([]*PubVerShare, n), PubPoly: tr.PubPoly}
+	c := suite.Scalar().Pick(random.Stream)
+	for i, s := range tr.EncShares {
+		sH := tr.PubPoly.Eval(s.S.I).V
+		sX := suite.Point().Pick(random.Stream)
+		r := suite.Scalar().Pick(random.Stream)
+		forged.EncShares[i] = &PubVerShare{share.PubShare{I: s.S.I, V: sX}, dleq.Proof{
+			C:  c,
+			R:  r,
+			VG: suite.Point().Add(suite.Point().Mul(r, tr.H), suite.Point().Mul(c, sH)),
+			VH: suite.Point().Add(suite.Point().Mul(r, tr.X[i]), suite.Point().Mul(c, sX)),
+		}}
+	}
+	ok, errs, err = VerifyTranscript(suite, forged)
+	require.Equal(test, ErrBadChallenge, err)
+	require.False(test, ok)
+	require.Nil(test, errs)
+
+	// so is a share checked against a tampered polynomial
+	tr2 := newTranscript(test, suite, n)
+	tr2.PubPoly = newTranscript(test, suite, n).PubPoly
+	ok, errs, err = VerifyTranscript(suite, tr2)
+	require.Nil(test, err)
+	require.False(test, ok)
+	require.Equal(test, errorEncVerification, errs[0])
+
+	_, _, err = VerifyTranscript(suite, &Transcript{H: tr.H, X: tr.X[1:], EncShares: tr.EncShares, PubPoly: tr.PubPoly})
+	require.Equal(test, errorDifferentLengths, err)
+}
+
+func BenchmarkVerifyTranscript(b *testing.B) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	tr := newTranscript(b, suite, 50)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _, _ := VerifyTranscript(suite, tr); !ok {
+			b.Fatal("invalid transcript")
+		}
+	}
+}
+
+func BenchmarkVerifyTranscriptLoop(b *testing.B) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	tr := newTranscript(b, suite, 50)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, s := range tr.EncShares {
+			sH := tr.PubPoly.Eval(s.S.I).V
+			if err := VerifyEncShare(suite, tr.H, tr.X[j], sH, s); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestPVSSEncSharesWithNonce(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	G := suite.Point().Base()
+	n := 5
+	t := 3
+	x, X := newCommittee(suite, n)
+	secret := suite.Scalar().Pick(random.Stream)
+
+	nonce1 := random.Bytes(32, random.Stream)
+	nonce2 := random.Bytes(32, random.Stream)
+	tr1, err := EncSharesWithNonce(suite, nonce1, X, secret, t)
+	require.Nil(test, err)
+	tr2, err := EncSharesWithNonce(suite, nonce2, X, secret, t)
+	require.Nil(test, err)
+
+	// the base points depend on the nonces only, not on the committee
+	require.Equal(test, nonce1, tr1.Nonce)
+	require.False(test, tr1.H.Equal(tr2.H))
+	require.False(test, tr1.H.Equal(BaseForCommittee(suite, X)))
+	require.Nil(test, VerifyBaseNonce(suite, tr1.Nonce, tr1.H))
+	require.Nil(test, VerifyBaseNonce(suite, tr2.Nonce, tr2.H))
+	require.Equal(test, errorBase, VerifyBaseNonce(suite, tr1.Nonce, tr2.H))
+	require.Equal(test, errorNonce, VerifyBaseNonce(suite, nil, tr1.H))
+	_, err = EncSharesWithNonce(suite, nil, X, secret, t)
+	require.Equal(test, errorNonce, err)
+
+	for _, tr := range []*Transcript{tr1, tr2} {
+		ok, _, err := VerifyTranscript(suite, tr)
+		require.Nil(test, err)
+		require.True(test, ok)
+
+		// the nonce survives serialization, so H can still be checked
+		buf, err := tr.MarshalBinary()
+		require.Nil(test, err)
+		dec, err := UnmarshalTranscript(suite, buf)
+		require.Nil(test, err)
+		require.Equal(test, tr.Nonce, dec.Nonce)
+		ok, _, err = VerifyTranscript(suite, dec)
+		require.Nil(test, err)
+		require.True(test, ok)
+		_, err = UnmarshalTranscript(suite, buf[:len(buf)-1])
+		require.Equal(test, errorTranscriptFormat, err)
+
+		decShares := make([]*PubVerShare, n)
+		for i, s := range tr.EncShares {
+			sH := tr.PubPoly.Eval(s.S.I).V
+			decShares[i], err = DecShare(suite, tr.H, X[i], sH, x[i], s)
+			require.Nil(test, err)
+		}
+		recovered, err := RecoverSecret(suite, G, X, tr.EncShares, decShares, t, n)
+		require.Nil(test, err)
+		require.True(test, recovered.Equal(suite.Point().Mul(secret, G)))
+	}
+
+	// H must have been derived from the recorded nonce
+	forged := *tr1
+	forged.Nonce = nonce2
+	_, _, err = VerifyTranscript(suite, &forged)
+	require.Equal(test, errorBase, err)
+}
+
+// sha512Suite is Ed25519 with SHA-512 for the Fiat-Shamir challenges.
+type sha512Suite struct {
+	*edwards25519.SuiteEd25519
+}
+
+func (s sha512Suite) Hash() hash.Hash {
+	return sha512.New()
+}
+
+func TestPVSSHashMismatch(test *testing.T) {
+	dealer := sha512Suite{edwards25519.NewAES128SHA256Ed25519()}
+	verifier := edwards25519.NewAES128SHA256Ed25519()
+	n := 4
+	_, X := newCommittee(verifier, n)
+	secret := dealer.Scalar().Pick(random.Stream)
+	tr, err := EncSharesWithNonce(dealer, []byte("nonce"), X, secret, 3)
+	require.Nil(test, err)
+
+	// suites of the same hash share their identifier, whatever their cipher
+	require.Equal(test, HashID(verifier), HashID(edwards25519.NewAES256SHA256Ed25519()))
+	require.NotEqual(test, HashID(verifier), HashID(dealer))
+	require.Equal(test, HashID(dealer), tr.Hash)
+
+	ok, _, err := VerifyTranscript(dealer, tr)
+	require.Nil(test, err)
+	require.True(test, ok)
+	ok, errs, err := VerifyTranscript(verifier, tr)
+	require.Equal(test, ErrHashMismatch, err)
+	require.False(test, ok)
+	require.Nil(test, errs)
+
+	// the hash survives serialization
+	buf, err := tr.MarshalBinary()
+	require.Nil(test, err)
+	dec, err := UnmarshalTranscript(dealer, buf)
+	require.Nil(test, err)
+	require.Equal(test, tr.Hash, dec.Hash)
+	_, _, err = VerifyTranscript(verifier, dec)
+	require.Equal(test, ErrHashMismatch, err)
+
+	// without it, the mismatch is caught by the challenge of the proofs
+	plain := newTranscript(test, dealer, n)
+	ok, _, err = VerifyTranscript(dealer, plain)
+	require.Nil(test, err)
+	require.True(test, ok)
+	ok, errs, err = VerifyTranscript(verifier, plain)
+	require.Equal(test, ErrBadChallenge, err)
+	require.False(test, ok)
+	require.Nil(test, errs)
+
+	// the collective challenge of the proofs is the dealer's hash of the
+	// commitments, the encrypted shares and the proofs' commitments
+	var data [][]byte
+	for _, f := range []func(s *PubVerShare, sH kyber.Point) kyber.Point{
+		func(s *PubVerShare, sH kyber.Point) kyber.Point { return sH },
+		func(s *PubVerShare, sH kyber.Point) kyber.Point { return s.S.V },
+		func(s *PubVerShare, sH kyber.Point) kyber.Point { return s.P.VG },
+		func(s *PubVerShare, sH kyber.Point) kyber.Point { return s.P.VH },
+	} {
+		for _, s := range tr.EncShares {
+			buf, err := f(s, tr.PubPoly.Eval(s.S.I).V).MarshalBinary()
+			require.Nil(test, err)
+			data = append(data, buf)
+		}
+	}
+	require.True(test, tr.EncShares[0].P.C.Equal(group.HashToScalar(dealer, data...)))
+	require.False(test, tr.EncShares[0].P.C.Equal(group.HashToScalar(verifier, data...)))
+}
@@ -0,0 +1,160 @@
+package pvss
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// Parameters of the test vectors: number of trustees and threshold.
+const vectorN, vectorT = 4, 3
+
+// seededSource is a deterministic entropy source for test vectors.
+type seededSource struct {
+	io.Reader
+}
+
+func (s *seededSource) HealthCheck() error { return nil }
+
+// generateTestVector runs a full PVSS deterministically derived from the seed
+// and returns its serialized transcript, for regression tests: the same seed
+// yields the same bytes as long as the scheme is unchanged. The transcript is
+// the concatenation of H, the trustee public keys and the commitments (both
+// encoded with kyber.MarshalPoints), the encrypted shares, the decrypted
+// shares and the recovered secret.
+//
+// The randomness used within the package is made deterministic by temporarily
+// replacing the entropy source of the random package, so generateTestVector
+// must not run concurrently with anything else using random.Stream.
+func generateTestVector(suite Suite, seed []byte) ([]byte, error) {
+	prev := random.Source()
+	internal := suite.Cipher(append([]byte("pvss-test-vector"), seed...))
+	random.SetSource(&seededSource{internal})
+	defer random.SetSource(prev)
+
+	rand := suite.Cipher(seed)
+	G := suite.Point().Base()
+	H := suite.Point().Pick(rand)
+	x := make([]kyber.Scalar, vectorN)
+	X := make([]kyber.Point, vectorN)
+	for i := range x {
+		x[i] = suite.Scalar().Pick(rand)
+		X[i] = suite.Point().Mul(x[i], nil)
+	}
+	secret := suite.Scalar().Pick(rand)
+
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, vectorT)
+	if err != nil {
+		return nil, err
+	}
+	decShares := make([]*PubVerShare, vectorN)
+	for i := range decShares {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		if decShares[i], err = DecShare(suite, H, X[i], sH, x[i], encShares[i]); err != nil {
+			return nil, err
+		}
+	}
+	recovered, err := RecoverSecret(suite, G, X, encShares, decShares, vectorT, vectorN)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if _, err := H.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	_, commits := pubPoly.Info()
+	for _, points := range [][]kyber.Point{X, commits} {
+		buf, err := kyber.MarshalPoints(points)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(buf)
+	}
+	for _, shares := range [][]*PubVerShare{encShares, decShares} {
+		for _, s := range shares {
+			if err := writePubVerShare(&b, s); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if _, err := recovered.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// vectorEd25519 is the transcript of generateTestVector on edwards25519 with
+// the seed "pvss". It must only be regenerated on purpose, when the scheme or
+// its serialization changes.
+const vectorEd25519 = "" +
+	"2088b2aa10fd3369ed596b4d038bfe6292e02e08485be481e2341c18f95ba194" +
+	"00000004ab9046751d2c39b4129b923f8580661bed0fdb2a023604a06d7c3c8d" +
+	"669d10369ed1adab7cca8ff8af58124b0d1507383b27e9e8a8acc1e3704f6bff" +
+	"49d77844da9271dff159a18a34ccbf4c7343c911ef9e722ea6fd27604d73e077" +
+	"c05bf7e7c15317d0710720bab9f44de6201b4d259737e1c6083eb8953b661918" +
+	"982e667100000003d55bdb96df6021d1d3bba69250c58ffea545ab6b1efe9363" +
+	"a9a3737a9bdf27c97d5c2c4e9eb3e5f51620c367d2e0ada9563ab53b99db5eb8" +
+	"1a3fe86d76a4aa9f935b64ac58921da25ff415be2d1bcc86c24a889f3a36124b" +
+	"428b0214cf4e3bc200000000885f5d5077567b12e335a93d68f3f9e355145326" +
+	"6b52d32731315b88646cc20c90ee64857c7b6bdc0ea83c9c894905a1ab47d8eb" +
+	"08b322683e6b782989e2430b71540f3a1b3802ba21e08a2fb5b09d9810f6eeef" +
+	"5103e0f25dc8ec4b8c68fc0e7750187bd7be35847e871ed790182c48e9eeba17" +
+	"a23bcd600ff17446520be3d0963d04065401cc6560b9ed7adb04d56e59cc9e52" +
+	"fca4a13344e97eca0144af890000000113390caf455d88967f9c110b0064c1b1" +
+	"8100a50a22c305d2f00929cf6d472e7c90ee64857c7b6bdc0ea83c9c894905a1" +
+	"ab47d8eb08b322683e6b782989e2430bde5f1d6f8186cb0e1038963b34314dbc" +
+	"6360abf166c67ffc9df3450de3fcda0d56b542782222271143f65dee07c3e6f9" +
+	"82c10061a3bb5d4bf2ac57c4a6511d82b80fdddbab9953765f77bb76f1638c8c" +
+	"39493d5dc1ea95263c0cc3cd329bf78200000002a5aee57effa69928c40816a6" +
+	"629d1757e470684b609ac457d4124e39bee807ba90ee64857c7b6bdc0ea83c9c" +
+	"894905a1ab47d8eb08b322683e6b782989e2430b538efb196a4750555efc6b2c" +
+	"dacc06c85dd87979dc9a5c3dc61c50babe0abd0714b5128970fa9a45dcbc47aa" +
+	"3dab7f9cf5f41d308b57cbfdb970deed2acc5deb2a8897a4b2f37557ea8bdb35" +
+	"006402227783b3d6a91d77aabf7c3f3acd9872740000000378f959d5347ca06f" +
+	"1a7c6c891a9361374698a1c1c16c15068e7571fcdf28ed6b90ee64857c7b6bdc" +
+	"0ea83c9c894905a1ab47d8eb08b322683e6b782989e2430b0ae5da032b8fa83b" +
+	"99aa3e19c484924ae7db442ec2db4c6e5de49252bebd710b1e7a5351bd996892" +
+	"f877df5ee6e67385447b359bf4f7d5afc3b641571d8b7ca047c19662bb42ad83" +
+	"4cddd24655bbbaed6ff8dbeda9d8470c67d2a84f4951c56e00000000bec5e937" +
+	"d3b15a751dcf28996070ae29684fd9cd7f16ddcd7893838791b06a3296989220" +
+	"c5a5b5f0a6aa392c0ae8b50c62f5c063d26af7aef2a34e1b312b2f07e9e3ef2e" +
+	"b1bcb6bdfe43b226aa5d7b2b14b10ffe001d726150db8bb9ab692d0830db11ca" +
+	"bf173c4549ea76a6a82291ec766cf60ce975bff20b654e1ebad7d8779ca88090" +
+	"203a211d7698d40fb5d03d3594e662ca731e9fb1a50c5014900d2dae00000001" +
+	"dda5ba1d12b53998b4b92ed317d5db95199f5845a388382c5dc25ab38700ad04" +
+	"c9a24cdcbf696093b4b2654abfb13924da7abb68613122c1a4cc03f80ee1b206" +
+	"3db9705daaffa1dfee2f6f4881af4b29747ad0e774e9f48934b543b51109bd07" +
+	"fd82eb00973a1d4d49b8c059d27db9c0915dbc380f695535c0e8f143afef1e86" +
+	"f2369ec55c85b238dcc64b2988fe26ce1ffcc5e1bc96b78046e01814b7daa771" +
+	"00000002f0250bf775768f1b874518e667fcd249f6d826a94dc4acec83e49a59" +
+	"6e209d410971c9e6607b0dd1af5d63ebefbb9d29be91460d3fea42249374567e" +
+	"612a5d07e001b97c5188d77b3b74ff6b6e1b3f5a875f5b56e606a77b96299448" +
+	"4a35690b307e27cb95dc56a9a3c86d549ce14cc3dafe451d2dadab1ee3e4f5b4" +
+	"3b13bb0f1868e51718d7055b751b4befbbaeb9d4173fddea1b8dda3d01550d4c" +
+	"97d2f3d200000003ae630f0113c63a51292b2886c3f762e49b9db2ccb0527c19" +
+	"763a9df7a21f9bb40e00e20f405e9eaab32897a9e9206bcd1cc54b8d8214f85d" +
+	"c8a0c3c76dd60109435f8cddc2e8bb491f7485a23c072ee183ef8732bb6dce95" +
+	"c9ae7653a5e4690f2437294399d927c9e77e7d69a18355efccdec331e9c884a9" +
+	"0e61c4eb0a2b0bc2e129e3fe31f7c652bb30b2531ac7f197e9bdb610c6b32fe2" +
+	"ccd6b6b3cea599a3da967f1d213f48c9afd6ec6a26b3b29173f905cc7e1f6783" +
+	"90cc9aa59cf5f589"
+
+func TestPVSSVector(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	want, err := hex.DecodeString(vectorEd25519)
+	require.Nil(test, err)
+	got, err := generateTestVector(suite, []byte("pvss"))
+	require.Nil(test, err)
+	require.Equal(test, want, got)
+
+	other, err := generateTestVector(suite, []byte("other"))
+	require.Nil(test, err)
+	require.NotEqual(test, want, other)
+}
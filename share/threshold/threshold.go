@@ -0,0 +1,124 @@
+// Package threshold implements threshold ElGamal encryption on top of a
+// distributed key generated with the share/dkg packages. A message is
+// encrypted to the distributed public key and can only be decrypted once a
+// threshold of share holders have collaborated:
+//  1. Anyone encrypts a message to the distributed public key with Encrypt().
+//  2. Each share holder computes a partial decryption of the ephemeral key
+//     with PartialDecrypt(), together with a DLEQ proof of correctness.
+//  3. A combiner checks the partial decryptions with VerifyPartialDecrypt()
+//     and, once enough of them are valid, recovers the message via Lagrange
+//     interpolation in the exponent using Combine().
+//
+// This is the same technique PVSS uses to decrypt its shares, applied to
+// ElGamal ciphertexts.
+package threshold
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+)
+
+// Suite describes the functionalities needed by this package in order to
+// function correctly.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.CipherFactory
+}
+
+// DistKeyShare is an abstraction to allow one to use distributed key shares
+// from different schemes easily with this threshold encryption scheme.
+type DistKeyShare interface {
+	PriShare() *share.PriShare
+	Commitments() []kyber.Point
+}
+
+// Some error definitions.
+var errorInvalidPartial = errors.New("threshold: verification of partial decryption failed")
+var errorTooFewPartials = errors.New("threshold: not enough partial decryptions to combine")
+
+// Partial is a partial decryption xi*K of the ephemeral key K issued by the
+// share holder of index I, together with a proof that log_{G}(xi*G) ==
+// log_{K}(xi*K).
+type Partial struct {
+	S share.PubShare // Partial decryption
+	P dleq.Proof     // Proof
+}
+
+// Encrypt ElGamal-encrypts the message to the distributed public key. The
+// message (or as much of it as fits) is embedded into a point and the
+// function returns the ciphertext (K,C) and the bytes that did not fit.
+func Encrypt(suite Suite, public kyber.Point, msg []byte) (K, C kyber.Point, remainder []byte) {
+	M := suite.Point().Embed(msg, random.Stream)
+	max := suite.Point().EmbedLen()
+	if max > len(msg) {
+		max = len(msg)
+	}
+	remainder = msg[max:]
+	k := suite.Scalar().Pick(random.Stream) // ephemeral private key
+	K = suite.Point().Mul(k, nil)           // ephemeral public key
+	S := suite.Point().Mul(k, public)       // shared secret
+	C = S.Add(S, M)                         // blinded message
+	return K, C, remainder
+}
+
+// PartialDecrypt computes the partial decryption of the ephemeral key K with
+// the private share of the given distributed key share, together with a
+// decryption consistency proof.
+func PartialDecrypt(suite Suite, K kyber.Point, dks DistKeyShare) (*Partial, error) {
+	pri := dks.PriShare()
+	G := suite.Point().Base()
+	P, _, D, err := dleq.NewDLEQProof(suite, G, K, pri.V)
+	if err != nil {
+		return nil, err
+	}
+	return &Partial{share.PubShare{I: pri.I, V: D}, *P}, nil
+}
+
+// VerifyPartialDecrypt checks that the partial decryption was correctly
+// computed from the ephemeral key K, i.e. that log_{G}(X) == log_{K}(D) where
+// X is the public share of the issuer, obtained by evaluating the public
+// commitment polynomial of the distributed key at the partial's index.
+func VerifyPartialDecrypt(suite Suite, K kyber.Point, pubPoly *share.PubPoly, p *Partial) error {
+	if p == nil || p.S.V == nil || p.S.I < 0 {
+		return errorInvalidPartial
+	}
+	G := suite.Point().Base()
+	X := pubPoly.Eval(p.S.I).V
+	if err := p.P.VerifySingle(suite, G, K, X, p.S.V); err != nil {
+		return errorInvalidPartial
+	}
+	return nil
+}
+
+// Combine verifies the given partial decryptions against the public
+// commitment polynomial of the distributed key and, if at least t of them are
+// valid, recovers the shared secret xK and returns the decrypted message
+// point M = C - xK. The embedded data can then be retrieved with M.Data().
+// Only the first valid partial of each index counts, and the partials of
+// indices outside [0, n) are ignored.
+func Combine(suite Suite, pubPoly *share.PubPoly, K, C kyber.Point, partials []*Partial, t, n int) (kyber.Point, error) {
+	var shares []*share.PubShare
+	seen := make(map[int]bool)
+	for _, p := range partials {
+		if p == nil || p.S.I >= n || seen[p.S.I] {
+			continue
+		}
+		if err := VerifyPartialDecrypt(suite, K, pubPoly, p); err == nil {
+			seen[p.S.I] = true
+			shares = append(shares, &p.S)
+		}
+	}
+	if len(shares) < t {
+		return nil, errorTooFewPartials
+	}
+	S, err := share.RecoverCommit(suite, shares, t, n)
+	if err != nil {
+		return nil, err
+	}
+	return suite.Point().Sub(C, S), nil
+}
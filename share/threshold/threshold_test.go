@@ -0,0 +1,214 @@
+package threshold
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/encrypt"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewAES128SHA256Ed25519()
+
+func genDistKeyShares(t, n int) ([]*test.DistKeyShare, *share.PubPoly) {
+	return test.NewDistKeyShares(suite, t, n)
+}
+
+func TestThresholdElGamal(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+
+	msg := []byte("threshold elgamal")
+	K, C, remainder := Encrypt(suite, pubPoly.Commit(), msg)
+	require.Equal(test, 0, len(remainder))
+
+	partials := make([]*Partial, n)
+	for i := range dks {
+		p, err := PartialDecrypt(suite, K, dks[i])
+		require.Nil(test, err)
+		require.Nil(test, VerifyPartialDecrypt(suite, K, pubPoly, p))
+		partials[i] = p
+	}
+
+	// Any t partial decryptions are enough
+	M, err := Combine(suite, pubPoly, K, C, partials[n-t:], t, n)
+	require.Nil(test, err)
+	data, err := M.Data()
+	require.Nil(test, err)
+	require.Equal(test, msg, data)
+}
+
+func TestThresholdElGamalBadPartials(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	K, C, _ := Encrypt(suite, pubPoly.Commit(), []byte("hello"))
+
+	partials := make([]*Partial, n)
+	for i := range dks {
+		p, err := PartialDecrypt(suite, K, dks[i])
+		require.Nil(test, err)
+		partials[i] = p
+	}
+
+	// Corrupt some of the partial decryptions
+	partials[0].S.V = suite.Point().Null()
+	partials[1].S.I = 4
+	require.Equal(test, errorInvalidPartial, VerifyPartialDecrypt(suite, K, pubPoly, partials[0]))
+	require.Equal(test, errorInvalidPartial, VerifyPartialDecrypt(suite, K, pubPoly, partials[1]))
+
+	M, err := Combine(suite, pubPoly, K, C, partials, t, n)
+	require.Nil(test, err)
+	data, err := M.Data()
+	require.Nil(test, err)
+	require.Equal(test, []byte("hello"), data)
+
+	// Corrupt one more to drop below the threshold
+	partials[2].S.V = suite.Point().Null()
+	_, err = Combine(suite, pubPoly, K, C, partials, t, n)
+	require.Equal(test, errorTooFewPartials, err)
+}
+
+func TestThresholdElGamalDuplicatePartials(test *testing.T) {
+	n := 5
+	t := 3
+	// one share more than the committee holds, for an out of range partial
+	dks, pubPoly := genDistKeyShares(t, n+1)
+	K, C, _ := Encrypt(suite, pubPoly.Commit(), []byte("hello"))
+
+	p0, err := PartialDecrypt(suite, K, dks[0])
+	require.Nil(test, err)
+	p1, err := PartialDecrypt(suite, K, dks[1])
+	require.Nil(test, err)
+
+	// a partial submitted twice counts once
+	_, err = Combine(suite, pubPoly, K, C, []*Partial{p0, p1, p0}, t, n)
+	require.Equal(test, errorTooFewPartials, err)
+
+	// a valid partial of an index beyond the committee doesn't count either
+	p5, err := PartialDecrypt(suite, K, dks[n])
+	require.Nil(test, err)
+	require.Nil(test, VerifyPartialDecrypt(suite, K, pubPoly, p5))
+	_, err = Combine(suite, pubPoly, K, C, []*Partial{p0, p1, p5}, t, n)
+	require.Equal(test, errorTooFewPartials, err)
+
+	p2, err := PartialDecrypt(suite, K, dks[2])
+	require.Nil(test, err)
+	M, err := Combine(suite, pubPoly, K, C, []*Partial{p0, p0, p1, p2, nil}, t, n)
+	require.Nil(test, err)
+	data, err := M.Data()
+	require.Nil(test, err)
+	require.Equal(test, []byte("hello"), data)
+}
+
+func TestThresholdElGamalForgedPartial(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	K, C, _ := Encrypt(suite, pubPoly.Commit(), []byte("hello"))
+
+	partials := make([]*Partial, n)
+	for i := range dks {
+		p, err := PartialDecrypt(suite, K, dks[i])
+		require.Nil(test, err)
+		partials[i] = p
+	}
+
+	// a random challenge and response satisfy the proof's equations for any D
+	G := suite.Point().Base()
+	X := pubPoly.Eval(0).V
+	D := suite.Point().Pick(random.Stream)
+	c := suite.Scalar().Pick(random.Stream)
+	r := suite.Scalar().Pick(random.Stream)
+	forged := &Partial{share.PubShare{I: 0, V: D}, dleq.Proof{
+		C:  c,
+		R:  r,
+		VG: suite.Point().Add(suite.Point().Mul(r, G), suite.Point().Mul(c, X)),
+		VH: suite.Point().Add(suite.Point().Mul(r, K), suite.Point().Mul(c, D)),
+	}}
+	require.Nil(test, forged.P.Verify(suite, G, K, X, D))
+	require.Equal(test, errorInvalidPartial, VerifyPartialDecrypt(suite, K, pubPoly, forged))
+
+	// so it doesn't take the place of the honest partial of its index
+	M, err := Combine(suite, pubPoly, K, C, append([]*Partial{forged}, partials[:t]...), t, n)
+	require.Nil(test, err)
+	data, err := M.Data()
+	require.Nil(test, err)
+	require.Equal(test, []byte("hello"), data)
+}
+
+func TestThresholdElGamalReEncrypt(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	msg := []byte("re-encrypted")
+	K, C, _ := Encrypt(suite, pubPoly.Commit(), msg)
+
+	Ks := []kyber.Point{K}
+	Cs := []kyber.Point{C}
+	for i := 0; i < 4; i++ {
+		K, C = encrypt.ReEncrypt(suite, pubPoly.Commit(), K, C, random.Stream)
+		for j := range Ks {
+			require.False(test, K.Equal(Ks[j]))
+			require.False(test, C.Equal(Cs[j]))
+		}
+		Ks = append(Ks, K)
+		Cs = append(Cs, C)
+	}
+
+	partials := make([]*Partial, n)
+	for i := range dks {
+		p, err := PartialDecrypt(suite, K, dks[i])
+		require.Nil(test, err)
+		partials[i] = p
+	}
+	M, err := Combine(suite, pubPoly, K, C, partials, t, n)
+	require.Nil(test, err)
+	data, err := M.Data()
+	require.Nil(test, err)
+	require.Equal(test, msg, data)
+}
+
+func TestThresholdElGamalAddCiphertexts(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	public := pubPoly.Commit()
+
+	// votes encrypted in the exponent, as re-randomized trivial encryptions
+	// (0, mG) of mG
+	votes := []int64{3, 0, 5}
+	cts := make([]encrypt.Ciphertext, len(votes))
+	for i, v := range votes {
+		M := suite.Point().Mul(suite.Scalar().SetInt64(v), nil)
+		cts[i].K, cts[i].C = encrypt.ReEncrypt(suite, public, suite.Point().Null(), M, random.Stream)
+	}
+	tally := encrypt.AddCiphertexts(suite, cts)
+
+	partials := make([]*Partial, n)
+	for i := range dks {
+		p, err := PartialDecrypt(suite, tally.K, dks[i])
+		require.Nil(test, err)
+		partials[i] = p
+	}
+	M, err := Combine(suite, pubPoly, tally.K, tally.C, partials, t, n)
+	require.Nil(test, err)
+
+	// discrete logarithm by exhaustive search over the possible tallies
+	total := int64(-1)
+	P := suite.Point().Null()
+	for i := int64(0); i <= 100; i++ {
+		if P.Equal(M) {
+			total = i
+			break
+		}
+		P.Add(P, suite.Point().Base())
+	}
+	require.Equal(test, int64(8), total)
+}
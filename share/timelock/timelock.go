@@ -0,0 +1,224 @@
+// Package timelock seals messages until a deadline by encrypting them to a
+// threshold decryption committee, reusing the threshold ElGamal encryption of
+// the share/threshold package. The committee holds a distributed key and its
+// members only release their partial decryptions once the deadline has
+// passed, so that the message can't be opened earlier unless t of them
+// collude:
+//  1. Anyone seals a message to the committee's public key with Seal().
+//  2. After the deadline, each member computes its partial decryption with
+//     PartialOpen(), which refuses to do so before the deadline.
+//  3. Anyone holding t valid partial decryptions recovers the message with
+//     Open().
+//
+// The message is encrypted with AES-GCM under a fresh random key, and only
+// that key is threshold ElGamal encrypted. As the partial decryptions only
+// depend on the ephemeral key, the sealed message carries a proof that its
+// sealer knows the ephemeral private key, bound to the deadline, following
+// TDH2 of "Securing Threshold Cryptosystems against Chosen Ciphertext
+// Attack" by Shoup and Gennaro. The members check it before decrypting, so
+// that nobody can obtain partial decryptions for the ephemeral key of a
+// sealed message by presenting it with an earlier deadline.
+package timelock
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/share/threshold"
+	"github.com/dedis/kyber/util/random"
+)
+
+// Suite describes the functionalities needed by this package in order to
+// function correctly.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.CipherFactory
+}
+
+// Some error definitions.
+var errorTooEarly = errors.New("timelock: deadline has not passed yet")
+var errorKeyTooLong = errors.New("timelock: group can't embed a message key")
+var errorInvalidKey = errors.New("timelock: decrypted message key is invalid")
+var errorInvalidProof = errors.New("timelock: proof of the sealed message is invalid")
+
+// keyLength is the length of the AES key protecting the sealed message. It
+// must fit in a single point of the group.
+const keyLength = 16
+
+// Sealed is a message sealed until Deadline. K = rG and C are the threshold
+// ElGamal encryption of the message key, and Ciphertext the message itself
+// encrypted with that key. KBar = r GBar, GBar being a second generator
+// nobody knows the discrete logarithm of, and the challenge E and response F
+// prove that log_G(K) == log_GBar(KBar) for all the other fields, including
+// the deadline.
+type Sealed struct {
+	Deadline   time.Time
+	K          kyber.Point
+	C          kyber.Point
+	Ciphertext []byte
+	KBar       kyber.Point
+	E          kyber.Scalar
+	F          kyber.Scalar
+}
+
+// Seal encrypts msg to the committee holding the distributed private key of
+// public, to be opened after the deadline.
+func Seal(suite Suite, public kyber.Point, deadline time.Time, msg []byte) (*Sealed, error) {
+	if suite.Point().EmbedLen() < keyLength {
+		return nil, errorKeyTooLong
+	}
+	key := random.Bytes(keyLength, random.Stream)
+	M := suite.Point().Embed(key, random.Stream)
+	r := suite.Scalar().Pick(random.Stream) // ephemeral private key
+	s := &Sealed{
+		Deadline: deadline,
+		K:        suite.Point().Mul(r, nil),
+		C:        suite.Point().Mul(r, public),
+		KBar:     suite.Point().Mul(r, generator(suite)),
+	}
+	s.C.Add(s.C, M)
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := s.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	s.Ciphertext = aead.Seal(nil, nonce(aead), msg, ad)
+
+	// proof of knowledge of r, bound to the deadline and the ciphertext
+	v := suite.Scalar().Pick(random.Stream)
+	W := suite.Point().Mul(v, nil)
+	WBar := suite.Point().Mul(v, generator(suite))
+	if s.E, err = s.challenge(suite, W, WBar); err != nil {
+		return nil, err
+	}
+	s.F = suite.Scalar().Mul(r, s.E)
+	s.F.Add(s.F, v)
+	return s, nil
+}
+
+// Verify checks the proof of the sealed message, i.e. that its sealer knows
+// the ephemeral private key and sealed it with this deadline.
+func (s *Sealed) Verify(suite Suite) error {
+	if s.K == nil || s.C == nil || s.KBar == nil || s.E == nil || s.F == nil {
+		return errorInvalidProof
+	}
+	// W = FG - EK and WBar = F GBar - E KBar
+	GBar := generator(suite)
+	W := suite.Point().Mul(s.F, nil)
+	W.Sub(W, suite.Point().Mul(s.E, s.K))
+	WBar := suite.Point().Mul(s.F, GBar)
+	WBar.Sub(WBar, suite.Point().Mul(s.E, s.KBar))
+	e, err := s.challenge(suite, W, WBar)
+	if err != nil {
+		return err
+	}
+	if !e.Equal(s.E) {
+		return errorInvalidProof
+	}
+	return nil
+}
+
+// PartialOpen computes the partial decryption of the sealed message with the
+// given distributed key share. It returns an error if now is before the
+// deadline of the sealed message, or if its proof is invalid, e.g. because
+// its deadline was changed.
+func PartialOpen(suite Suite, s *Sealed, dks threshold.DistKeyShare, now time.Time) (*threshold.Partial, error) {
+	if now.Before(s.Deadline) {
+		return nil, errorTooEarly
+	}
+	if err := s.Verify(suite); err != nil {
+		return nil, err
+	}
+	return threshold.PartialDecrypt(suite, s.K, dks)
+}
+
+// Open verifies the sealed message and the partial decryptions against the
+// public commitment polynomial of the committee's distributed key and, if at
+// least t of them are valid, returns the message.
+func Open(suite Suite, pubPoly *share.PubPoly, s *Sealed, partials []*threshold.Partial, t, n int) ([]byte, error) {
+	if err := s.Verify(suite); err != nil {
+		return nil, err
+	}
+	M, err := threshold.Combine(suite, pubPoly, s.K, s.C, partials, t, n)
+	if err != nil {
+		return nil, err
+	}
+	key, err := M.Data()
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keyLength {
+		return nil, errorInvalidKey
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := s.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nonce(aead), s.Ciphertext, ad)
+}
+
+// additionalData binds the deadline and the encrypted key to the ciphertext.
+func (s *Sealed) additionalData() ([]byte, error) {
+	ad := make([]byte, 8)
+	binary.BigEndian.PutUint64(ad, uint64(s.Deadline.UnixNano()))
+	for _, p := range []kyber.Point{s.K, s.C} {
+		buf, err := p.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		ad = append(ad, buf...)
+	}
+	return ad, nil
+}
+
+// challenge hashes the commitments W and WBar of the proof together with all
+// the fields of the sealed message but the proof itself.
+func (s *Sealed) challenge(suite Suite, W, WBar kyber.Point) (kyber.Scalar, error) {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("timelock-seal"))
+	ad, err := s.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	_, _ = h.Write(ad)
+	_, _ = h.Write(s.Ciphertext)
+	for _, P := range []kyber.Point{s.KBar, W, WBar} {
+		if _, err := P.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	return suite.Scalar().Pick(suite.Cipher(h.Sum(nil))), nil
+}
+
+// generator returns the second generator GBar of the proofs, hashed to a
+// point so that nobody knows its discrete logarithm.
+func generator(suite Suite) kyber.Point {
+	return suite.Point().Pick(suite.Cipher([]byte("timelock-generator")))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// nonce returns the all-zero nonce, which is safe since every message is
+// encrypted under a fresh key.
+func nonce(aead cipher.AEAD) []byte {
+	return make([]byte, aead.NonceSize())
+}
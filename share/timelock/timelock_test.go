@@ -0,0 +1,134 @@
+package timelock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/share/threshold"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewAES128SHA256Ed25519()
+
+func genDistKeyShares(t, n int) ([]*test.DistKeyShare, *share.PubPoly) {
+	return test.NewDistKeyShares(suite, t, n)
+}
+
+func TestTimelock(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	deadline := time.Now().Add(time.Hour)
+
+	// longer than what fits in a single point
+	msg := []byte("a vote that stays sealed until the polls have closed")
+	sealed, err := Seal(suite, pubPoly.Commit(), deadline, msg)
+	require.Nil(test, err)
+
+	// committee members refuse to help before the deadline
+	_, err = PartialOpen(suite, sealed, dks[0], deadline.Add(-time.Second))
+	require.Equal(test, errorTooEarly, err)
+
+	partials := make([]*threshold.Partial, n)
+	for i := range dks {
+		partials[i], err = PartialOpen(suite, sealed, dks[i], deadline)
+		require.Nil(test, err)
+	}
+
+	opened, err := Open(suite, pubPoly, sealed, partials[:t], t, n)
+	require.Nil(test, err)
+	require.Equal(test, msg, opened)
+
+	// not enough partial decryptions
+	_, err = Open(suite, pubPoly, sealed, partials[:t-1], t, n)
+	require.Error(test, err)
+
+	// the deadline is authenticated
+	moved := *sealed
+	moved.Deadline = deadline.Add(-time.Hour)
+	_, err = Open(suite, pubPoly, &moved, partials[:t], t, n)
+	require.Error(test, err)
+}
+
+func TestTimelockEarlierDeadline(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	deadline := time.Now().Add(time.Hour)
+	sealed, err := Seal(suite, pubPoly.Commit(), deadline, []byte("sealed for an hour"))
+	require.Nil(test, err)
+	require.Nil(test, sealed.Verify(suite))
+
+	// a copy with an earlier deadline fails its proof, so the members don't
+	// release partial decryptions for it ahead of the actual deadline
+	now := deadline.Add(-time.Minute)
+	moved := *sealed
+	moved.Deadline = now.Add(-time.Hour)
+	require.Equal(test, errorInvalidProof, moved.Verify(suite))
+	var partials []*threshold.Partial
+	for i := range dks {
+		p, err := PartialOpen(suite, &moved, dks[i], now)
+		require.Equal(test, errorInvalidProof, err)
+		if p != nil {
+			partials = append(partials, p)
+		}
+	}
+	_, err = Open(suite, pubPoly, sealed, partials, t, n)
+	require.Error(test, err)
+
+	// nor can the proof be redone for the earlier deadline without the
+	// ephemeral private key
+	other, err := Seal(suite, pubPoly.Commit(), moved.Deadline, []byte("sealed for an hour"))
+	require.Nil(test, err)
+	moved.E, moved.F = other.E, other.F
+	require.Equal(test, errorInvalidProof, moved.Verify(suite))
+
+	// any other change invalidates the proof as well
+	tampered := *sealed
+	tampered.Ciphertext = append([]byte{}, sealed.Ciphertext...)
+	tampered.Ciphertext[0] ^= 1
+	require.Equal(test, errorInvalidProof, tampered.Verify(suite))
+	tampered = *sealed
+	tampered.KBar = suite.Point().Pick(random.Stream)
+	require.Equal(test, errorInvalidProof, tampered.Verify(suite))
+	_, err = PartialOpen(suite, &tampered, dks[0], deadline)
+	require.Equal(test, errorInvalidProof, err)
+}
+
+func TestTimelockForgedPartial(test *testing.T) {
+	n := 5
+	t := 3
+	dks, pubPoly := genDistKeyShares(t, n)
+	deadline := time.Now().Add(-time.Minute)
+	msg := []byte("opened by the honest members")
+	sealed, err := Seal(suite, pubPoly.Commit(), deadline, msg)
+	require.Nil(test, err)
+	partials := make([]*threshold.Partial, t)
+	for i := range partials {
+		partials[i], err = PartialOpen(suite, sealed, dks[i], time.Now())
+		require.Nil(test, err)
+	}
+
+	// a member sending first a partial decryption of its index whose proof
+	// has a random challenge and response can't make the message open to
+	// anything else
+	G := suite.Point().Base()
+	X := pubPoly.Eval(0).V
+	D := suite.Point().Pick(random.Stream)
+	c := suite.Scalar().Pick(random.Stream)
+	r := suite.Scalar().Pick(random.Stream)
+	forged := &threshold.Partial{S: share.PubShare{I: 0, V: D}, P: dleq.Proof{
+		C:  c,
+		R:  r,
+		VG: suite.Point().Add(suite.Point().Mul(r, G), suite.Point().Mul(c, X)),
+		VH: suite.Point().Add(suite.Point().Mul(r, sealed.K), suite.Point().Mul(c, D)),
+	}}
+	opened, err := Open(suite, pubPoly, sealed, append([]*threshold.Partial{forged}, partials...), t, n)
+	require.Nil(test, err)
+	require.Equal(test, msg, opened)
+}
@@ -40,8 +40,10 @@ func encryptKey(suite Suite, rand cipher.Stream,
 	kp := new(key.Pair)
 	var Xb []byte
 	if hide {
-		kp.GenHiding(suite, rand)
-		Xb = kp.Hiding.HideEncode(rand)
+		for Xb == nil {
+			kp.GenHiding(suite, rand)
+			Xb = kp.Hiding.HideEncode(rand)
+		}
 	} else {
 		kp.Gen(suite, rand)
 		Xb, _ = kp.Public.MarshalBinary()
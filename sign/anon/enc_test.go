@@ -2,9 +2,9 @@ package anon
 
 import (
 	"bytes"
-	"fmt"
-	//"testing"
 	"encoding/hex"
+	"fmt"
+	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
@@ -93,3 +93,29 @@ func ExampleEncrypt_anonSet() {
 	// 00000090  22 01 7c dc ad 06 09 7a  62 8e 45 98              |".|....zb.E.|
 	// Decrypted: 'Hello World!'
 }
+
+func TestEncryptHiding(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	rand := suite.Cipher([]byte("example"))
+	X := make([]kyber.Point, 3)
+	for i := range X {
+		X[i] = suite.Point().Pick(rand)
+	}
+	mine := 1
+	x := suite.Scalar().Pick(rand)
+	X[mine] = suite.Point().Mul(x, nil)
+
+	// the ephemeral key is hidden in every ciphertext, whether or not the
+	// first encoding attempt succeeds
+	M := []byte("Hello World!")
+	for i := 0; i < 10; i++ {
+		C := Encrypt(suite, rand, M, Set(X), true)
+		MM, err := Decrypt(suite, C, Set(X), mine, x, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(M, MM) {
+			t.Fatal("Decryption failed to reproduce message")
+		}
+	}
+}
@@ -8,6 +8,9 @@ challenge times private key while the wikipedia article substracts them.
 
 The resulting signature is compatible with EdDSA verification algorithm
 when using the edwards25519 group, and by extension the CoSi verification algorithm.
+With edwards25519, a signature is exactly 64 bytes long, namely R || s where s
+is encoded canonically in little-endian, as in Ed25519. Verification rejects
+any signature whose s is not reduced modulo the group order.
 */
 package schnorr
 
@@ -68,6 +71,11 @@ func Verify(g kyber.Group, public kyber.Point, msg, sig []byte) error {
 	if err := s.UnmarshalBinary(sig[pointSize:]); err != nil {
 		return err
 	}
+	// reject non-canonical encodings of s, i.e. s >= order, to prevent
+	// signature malleability
+	if sBuff, err := s.MarshalBinary(); err != nil || !bytes.Equal(sBuff, sig[pointSize:]) {
+		return errors.New("schnorr: non-canonical encoding of s")
+	}
 	// recompute hash(public || R || msg)
 	h, err := hash(g, public, R, msg)
 	if err != nil {
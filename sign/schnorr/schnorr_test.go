@@ -1,6 +1,7 @@
 package schnorr
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dedis/kyber/group/edwards25519"
@@ -62,3 +63,52 @@ func TestEdDSACompatibility(t *testing.T) {
 	}
 
 }
+
+func TestSchnorrCanonicalEncoding(t *testing.T) {
+	msg := []byte("Hello Schnorr")
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	kp := key.NewKeyPair(suite)
+
+	s, err := Sign(suite, kp.Secret, msg)
+	assert.Nil(t, err)
+	assert.Len(t, s, 64)
+	assert.Nil(t, Verify(suite, kp.Public, msg, s))
+
+	// s is the canonical little-endian encoding of the response
+	resp := suite.Scalar()
+	assert.Nil(t, resp.UnmarshalBinary(s[32:]))
+	buff, err := resp.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, s[32:], buff)
+
+	// s + L encodes the same scalar modulo L but must be rejected
+	order, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+	sInt := new(big.Int).SetBytes(reverse(s[32:]))
+	sInt.Add(sInt, order)
+	malleable := make([]byte, 64)
+	copy(malleable, s[:32])
+	copy(malleable[32:], reverse(leftPad(sInt.Bytes(), 32)))
+	assert.Error(t, Verify(suite, kp.Public, msg, malleable))
+
+	// s = L is not canonical
+	copy(malleable[32:], reverse(leftPad(order.Bytes(), 32)))
+	assert.Error(t, Verify(suite, kp.Public, msg, malleable))
+
+	// all high bits set is not canonical
+	for i := 32; i < 64; i++ {
+		malleable[i] = 0xff
+	}
+	assert.Error(t, Verify(suite, kp.Public, msg, malleable))
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+func leftPad(b []byte, l int) []byte {
+	return append(make([]byte, l-len(b)), b...)
+}
@@ -0,0 +1,26 @@
+package kyber
+
+// SumN returns exactly n bytes derived from data with the hash of the suite,
+// e.g. to make identifiers of a given length out of public keys. For n up to
+// the digest size of the hash, it is the hash of data truncated to n bytes, so
+// that n equal to the digest size gives the plain hash. For larger n, the
+// digest is followed by the output of the suite's cipher keyed with it, used
+// as an extendable-output function.
+func SumN(suite interface {
+	HashFactory
+	CipherFactory
+}, data []byte, n int) []byte {
+	if n <= 0 {
+		return []byte{}
+	}
+	h := suite.Hash()
+	h.Write(data)
+	digest := h.Sum(nil)
+	if n <= len(digest) {
+		return digest[:n]
+	}
+	out := make([]byte, n)
+	copy(out, digest)
+	suite.Cipher(digest).Partial(out[len(digest):], nil, nil)
+	return out
+}
@@ -0,0 +1,28 @@
+package kyber_test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSumN(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	data := []byte("public key")
+	h := suite.Hash()
+	h.Write(data)
+	sum := h.Sum(nil)
+
+	require.Equal(t, sum, kyber.SumN(suite, data, len(sum)))
+	require.Equal(t, sum[:8], kyber.SumN(suite, data, 8))
+	require.Equal(t, []byte{}, kyber.SumN(suite, data, 0))
+
+	long := kyber.SumN(suite, data, 100)
+	require.Equal(t, 100, len(long))
+	require.Equal(t, sum, long[:len(sum)])
+	require.Equal(t, long, kyber.SumN(suite, data, 100))
+	require.Equal(t, long[:70], kyber.SumN(suite, data, 70))
+	require.NotEqual(t, long, kyber.SumN(suite, []byte("other key"), 100))
+}
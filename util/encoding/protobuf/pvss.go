@@ -0,0 +1,350 @@
+// Package protobuf encodes the PVSS proofs, shares and transcripts as
+// Protocol Buffers messages, for verifiers written in other languages. The
+// messages are defined in kyber.proto; the Go structs mirror them the way
+// generated code would, and the ToProto and FromProto functions convert them
+// from and to the kyber types of a given suite.
+//
+// The wire format is stable: the field numbers never change, points and
+// scalars are carried in the binary encoding of their group, and Marshal
+// writes the fields in ascending order, omitting the fields holding their
+// default value as in proto3, so that it is deterministic. Unmarshal skips
+// unknown fields.
+package protobuf
+
+import (
+	"errors"
+	"math"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/share/pvss"
+)
+
+var errorIndex = errors.New("protobuf: share index out of range")
+var errorNoProof = errors.New("protobuf: share without proof")
+var errorSuite = errors.New("protobuf: transcript of another suite")
+var errorLengths = errors.New("protobuf: public keys and shares of different lengths")
+var errorPoint = errors.New("protobuf: invalid point encoding")
+var errorScalar = errors.New("protobuf: invalid or non-canonical scalar encoding")
+
+// Proof is the message of a dleq.Proof.
+type Proof struct {
+	C  []byte
+	R  []byte
+	VG []byte
+	VH []byte
+}
+
+// PubVerShare is the message of a pvss.PubVerShare.
+type PubVerShare struct {
+	Index uint32
+	Value []byte
+	Proof *Proof
+}
+
+// Transcript is the message of a pvss.Transcript.
+type Transcript struct {
+	Suite      string
+	H          []byte
+	PublicKeys [][]byte
+	EncShares  []*PubVerShare
+	Commits    [][]byte
+}
+
+// Marshal returns the wire encoding of the message.
+func (m *Proof) Marshal() []byte {
+	var e encoder
+	e.bytes(1, m.C)
+	e.bytes(2, m.R)
+	e.bytes(3, m.VG)
+	e.bytes(4, m.VH)
+	return e.buf
+}
+
+// Unmarshal decodes the wire encoding of the message into m.
+func (m *Proof) Unmarshal(data []byte) error {
+	*m = Proof{}
+	return decode(data, func(field, wireType int, v uint64, b []byte) error {
+		var dst *[]byte
+		switch field {
+		case 1:
+			dst = &m.C
+		case 2:
+			dst = &m.R
+		case 3:
+			dst = &m.VG
+		case 4:
+			dst = &m.VH
+		default:
+			return nil
+		}
+		if wireType != wireBytes {
+			return errorWireType
+		}
+		*dst = b
+		return nil
+	})
+}
+
+// Marshal returns the wire encoding of the message.
+func (m *PubVerShare) Marshal() []byte {
+	var e encoder
+	e.varint(1, uint64(m.Index))
+	e.bytes(2, m.Value)
+	if m.Proof != nil {
+		e.element(3, m.Proof.Marshal())
+	}
+	return e.buf
+}
+
+// Unmarshal decodes the wire encoding of the message into m.
+func (m *PubVerShare) Unmarshal(data []byte) error {
+	*m = PubVerShare{}
+	return decode(data, func(field, wireType int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			if wireType != wireVarint {
+				return errorWireType
+			}
+			if v > math.MaxUint32 {
+				return errorIndex
+			}
+			m.Index = uint32(v)
+			return nil
+		case 2, 3:
+		default:
+			return nil
+		}
+		if wireType != wireBytes {
+			return errorWireType
+		}
+		switch field {
+		case 2:
+			m.Value = b
+		case 3:
+			m.Proof = new(Proof)
+			return m.Proof.Unmarshal(b)
+		}
+		return nil
+	})
+}
+
+// Marshal returns the wire encoding of the message.
+func (m *Transcript) Marshal() []byte {
+	var e encoder
+	e.bytes(1, []byte(m.Suite))
+	e.bytes(2, m.H)
+	for _, b := range m.PublicKeys {
+		e.element(3, b)
+	}
+	for _, s := range m.EncShares {
+		e.element(4, s.Marshal())
+	}
+	for _, b := range m.Commits {
+		e.element(5, b)
+	}
+	return e.buf
+}
+
+// Unmarshal decodes the wire encoding of the message into m.
+func (m *Transcript) Unmarshal(data []byte) error {
+	*m = Transcript{}
+	return decode(data, func(field, wireType int, v uint64, b []byte) error {
+		if field < 1 || field > 5 {
+			return nil
+		}
+		if wireType != wireBytes {
+			return errorWireType
+		}
+		switch field {
+		case 1:
+			m.Suite = string(b)
+		case 2:
+			m.H = b
+		case 3:
+			m.PublicKeys = append(m.PublicKeys, b)
+		case 4:
+			s := new(PubVerShare)
+			if err := s.Unmarshal(b); err != nil {
+				return err
+			}
+			m.EncShares = append(m.EncShares, s)
+		case 5:
+			m.Commits = append(m.Commits, b)
+		}
+		return nil
+	})
+}
+
+// ProofToProto converts a DLEQ proof to its message.
+func ProofToProto(p *dleq.Proof) (*Proof, error) {
+	m := &Proof{}
+	for _, f := range []struct {
+		dst *[]byte
+		src kyber.Marshaling
+	}{{&m.C, p.C}, {&m.R, p.R}, {&m.VG, p.VG}, {&m.VH, p.VH}} {
+		var err error
+		if *f.dst, err = f.src.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ProofFromProto decodes the message of a DLEQ proof in the given suite.
+func ProofFromProto(suite kyber.Group, m *Proof) (*dleq.Proof, error) {
+	p := &dleq.Proof{}
+	var err error
+	if p.C, err = scalar(suite, m.C); err != nil {
+		return nil, err
+	}
+	if p.R, err = scalar(suite, m.R); err != nil {
+		return nil, err
+	}
+	if p.VG, err = point(suite, m.VG); err != nil {
+		return nil, err
+	}
+	if p.VH, err = point(suite, m.VH); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PubVerShareToProto converts an encrypted or decrypted share to its
+// message.
+func PubVerShareToProto(s *pvss.PubVerShare) (*PubVerShare, error) {
+	if s.S.I < 0 || int64(s.S.I) > math.MaxUint32 {
+		return nil, errorIndex
+	}
+	m := &PubVerShare{Index: uint32(s.S.I)}
+	var err error
+	if m.Value, err = s.S.V.MarshalBinary(); err != nil {
+		return nil, err
+	}
+	if m.Proof, err = ProofToProto(&s.P); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PubVerShareFromProto decodes the message of a share in the given suite.
+func PubVerShareFromProto(suite kyber.Group, m *PubVerShare) (*pvss.PubVerShare, error) {
+	if m.Proof == nil {
+		return nil, errorNoProof
+	}
+	s := &pvss.PubVerShare{}
+	s.S.I = int(m.Index)
+	var err error
+	if s.S.V, err = point(suite, m.Value); err != nil {
+		return nil, err
+	}
+	p, err := ProofFromProto(suite, m.Proof)
+	if err != nil {
+		return nil, err
+	}
+	s.P = *p
+	return s, nil
+}
+
+// TranscriptToProto converts a transcript of the given suite to its message.
+// Only the commitments of the polynomial are kept: their base is H.
+func TranscriptToProto(suite kyber.Group, t *pvss.Transcript) (*Transcript, error) {
+	if len(t.X) != len(t.EncShares) {
+		return nil, errorLengths
+	}
+	m := &Transcript{Suite: suite.String()}
+	var err error
+	if m.H, err = t.H.MarshalBinary(); err != nil {
+		return nil, err
+	}
+	_, commits := t.PubPoly.Info()
+	if m.PublicKeys, err = pointsBytes(t.X); err != nil {
+		return nil, err
+	}
+	if m.Commits, err = pointsBytes(commits); err != nil {
+		return nil, err
+	}
+	m.EncShares = make([]*PubVerShare, len(t.EncShares))
+	for i, s := range t.EncShares {
+		if m.EncShares[i], err = PubVerShareToProto(s); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// TranscriptFromProto decodes the message of a transcript in the given suite,
+// which must be the one the transcript was made with.
+func TranscriptFromProto(suite kyber.Group, m *Transcript) (*pvss.Transcript, error) {
+	if m.Suite != suite.String() {
+		return nil, errorSuite
+	}
+	if len(m.PublicKeys) != len(m.EncShares) {
+		return nil, errorLengths
+	}
+	t := &pvss.Transcript{}
+	var err error
+	if t.H, err = point(suite, m.H); err != nil {
+		return nil, err
+	}
+	if t.X, err = points(suite, m.PublicKeys); err != nil {
+		return nil, err
+	}
+	commits, err := points(suite, m.Commits)
+	if err != nil {
+		return nil, err
+	}
+	t.PubPoly = share.NewPubPoly(suite, t.H, commits)
+	t.EncShares = make([]*pvss.PubVerShare, len(m.EncShares))
+	for i, s := range m.EncShares {
+		if t.EncShares[i], err = PubVerShareFromProto(suite, s); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func point(suite kyber.Group, b []byte) (kyber.Point, error) {
+	p := suite.Point()
+	if len(b) != p.MarshalSize() {
+		return nil, errorPoint
+	}
+	if err := p.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func scalar(suite kyber.Group, b []byte) (kyber.Scalar, error) {
+	s := suite.Scalar()
+	if !s.IsCanonical(b) {
+		return nil, errorScalar
+	}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func points(suite kyber.Group, bufs [][]byte) ([]kyber.Point, error) {
+	ps := make([]kyber.Point, len(bufs))
+	for i, b := range bufs {
+		var err error
+		if ps[i], err = point(suite, b); err != nil {
+			return nil, err
+		}
+	}
+	return ps, nil
+}
+
+func pointsBytes(ps []kyber.Point) ([][]byte, error) {
+	bufs := make([][]byte, len(ps))
+	for i, p := range ps {
+		var err error
+		if bufs[i], err = p.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return bufs, nil
+}
@@ -0,0 +1,107 @@
+package protobuf
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/share/pvss"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranscriptRoundTrip(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 5
+	_, keys, X := test.NewPVSSSetup(suite, n, 3)
+	H := pvss.BaseForCommittee(suite, X)
+	encShares, pubPoly, err := pvss.EncShares(suite, H, X, suite.Scalar().Pick(random.Stream), 3)
+	require.Nil(t, err)
+	transcript := &pvss.Transcript{H: H, X: X, EncShares: encShares, PubPoly: pubPoly}
+
+	m, err := TranscriptToProto(suite, transcript)
+	require.Nil(t, err)
+	var decoded Transcript
+	require.Nil(t, decoded.Unmarshal(m.Marshal()))
+	require.Equal(t, m, &decoded)
+
+	got, err := TranscriptFromProto(suite, &decoded)
+	require.Nil(t, err)
+	require.True(t, got.H.Equal(H))
+	require.True(t, got.PubPoly.Equal(pubPoly))
+	ok, _, err := pvss.VerifyTranscript(suite, got)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	// decrypted shares round-trip too
+	sH := pubPoly.Eval(encShares[0].S.I).V
+	decShare, err := pvss.DecShare(suite, H, X[0], sH, keys[0].Secret, encShares[0])
+	require.Nil(t, err)
+	ms, err := PubVerShareToProto(decShare)
+	require.Nil(t, err)
+	var decodedShare PubVerShare
+	require.Nil(t, decodedShare.Unmarshal(ms.Marshal()))
+	gotShare, err := PubVerShareFromProto(suite, &decodedShare)
+	require.Nil(t, err)
+	require.Nil(t, pvss.VerifyDecShare(suite, nil, X[0], encShares[0], gotShare))
+
+	decoded.Suite = "P256"
+	_, err = TranscriptFromProto(suite, &decoded)
+	require.Equal(t, errorSuite, err)
+}
+
+func TestPubVerShareGolden(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	s := &pvss.PubVerShare{
+		S: share.PubShare{I: 3, V: suite.Point().Base()},
+		P: dleq.Proof{
+			C:  suite.Scalar().SetInt64(1),
+			R:  suite.Scalar().SetInt64(2),
+			VG: suite.Point().Base(),
+			VH: suite.Point().Null(),
+		},
+	}
+	m, err := PubVerShareToProto(s)
+	require.Nil(t, err)
+	golden := "" +
+		"0803" + // index = 3
+		"1220" + "5866666666666666666666666666666666666666666666666666666666666666" +
+		"1a88" + "01" + // proof, 136 bytes
+		"0a20" + "0100000000000000000000000000000000000000000000000000000000000000" +
+		"1220" + "0200000000000000000000000000000000000000000000000000000000000000" +
+		"1a20" + "5866666666666666666666666666666666666666666666666666666666666666" +
+		"2220" + "0100000000000000000000000000000000000000000000000000000000000000"
+	require.Equal(t, golden, hex.EncodeToString(m.Marshal()))
+
+	buf, err := hex.DecodeString(golden)
+	require.Nil(t, err)
+	var decoded PubVerShare
+	require.Nil(t, decoded.Unmarshal(buf))
+	got, err := PubVerShareFromProto(suite, &decoded)
+	require.Nil(t, err)
+	require.Equal(t, 3, got.S.I)
+	require.True(t, got.S.V.Equal(s.S.V))
+	require.True(t, got.P.C.Equal(s.P.C))
+	require.True(t, got.P.R.Equal(s.P.R))
+	require.True(t, got.P.VH.Equal(s.P.VH))
+
+	// unknown fields are skipped
+	require.Nil(t, decoded.Unmarshal(append(buf, 0x28, 0x01, 0x32, 0x01, 0xff)))
+	_, err = PubVerShareFromProto(suite, &decoded)
+	require.Nil(t, err)
+
+	require.Equal(t, errorWire, decoded.Unmarshal(buf[:len(buf)-1]))
+	require.Equal(t, errorWireType, decoded.Unmarshal([]byte{0x10, 0x01}))
+
+	// non-canonical scalars are rejected
+	require.Nil(t, decoded.Unmarshal(buf))
+	decoded.Proof.C = make([]byte, 32)
+	for i := range decoded.Proof.C {
+		decoded.Proof.C[i] = 0xff
+	}
+	_, err = PubVerShareFromProto(suite, &decoded)
+	require.Equal(t, errorScalar, err)
+}
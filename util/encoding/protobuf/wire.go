@@ -0,0 +1,103 @@
+package protobuf
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Wire types of the Protocol Buffers encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errorWire = errors.New("protobuf: malformed or truncated message")
+var errorWireType = errors.New("protobuf: unexpected wire type")
+
+// encoder appends fields to a message in the order they are written. As in
+// proto3, fields holding the default value are omitted, except for embedded
+// messages and the elements of repeated fields.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) key(field, wireType int) {
+	e.uvarint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (e *encoder) varint(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.key(field, wireVarint)
+	e.uvarint(v)
+}
+
+func (e *encoder) bytes(field int, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	e.element(field, b)
+}
+
+// element writes b even if it is empty, as an element of a repeated field or
+// an embedded message.
+func (e *encoder) element(field int, b []byte) {
+	e.key(field, wireBytes)
+	e.uvarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// decode calls f on each field of the message, with its varint value or its
+// bytes depending on its wire type. Fields of the fixed-size wire types are
+// skipped, so that a message with fields unknown to f still decodes.
+func decode(data []byte, f func(field, wireType int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 || key>>3 == 0 || key>>3 > 1<<29-1 {
+			return errorWire
+		}
+		data = data[n:]
+		field, wireType := int(key>>3), int(key&7)
+		var v uint64
+		var b []byte
+		switch wireType {
+		case wireVarint:
+			if v, n = binary.Uvarint(data); n <= 0 {
+				return errorWire
+			}
+			data = data[n:]
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return errorWire
+			}
+			b = data[n : n+int(l)]
+			data = data[n+int(l):]
+		case wireFixed64, wireFixed32:
+			size := 8
+			if wireType == wireFixed32 {
+				size = 4
+			}
+			if len(data) < size {
+				return errorWire
+			}
+			data = data[size:]
+			continue
+		default:
+			return errorWireType
+		}
+		if err := f(field, wireType, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -101,3 +101,20 @@ func TestStructures(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, h7, h8)
 }
+
+func TestScalar(t *testing.T) {
+	p := suite.Point().Pick(random.Stream)
+	buf, err := p.MarshalBinary()
+	require.Nil(t, err)
+
+	// a point is framed as its encoding
+	a, err := hash.Scalar(suite, hash.Raw("ab"), p)
+	require.Nil(t, err)
+	b, err := hash.Scalar(suite, hash.Raw("ab"), hash.Raw(buf))
+	require.Nil(t, err)
+	require.True(t, a.Equal(b))
+
+	c, err := hash.Scalar(suite, hash.Raw("a"), hash.Raw("b"), p)
+	require.Nil(t, err)
+	require.False(t, a.Equal(c))
+}
@@ -0,0 +1,58 @@
+package hash
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"io"
+
+	"github.com/dedis/kyber"
+)
+
+// Framed is an input of Scalar, whose encoding of MarshalSize bytes is
+// written by MarshalTo. Points and scalars are Framed, and so is Raw.
+type Framed interface {
+	MarshalSize() int
+	MarshalTo(w io.Writer) (int, error)
+}
+
+// Raw is a Framed input writing the bytes as they are.
+type Raw []byte
+
+// MarshalSize returns the number of bytes of r.
+func (r Raw) MarshalSize() int { return len(r) }
+
+// MarshalTo writes the bytes of r to w.
+func (r Raw) MarshalTo(w io.Writer) (int, error) { return w.Write(r) }
+
+// Scalar hashes the inputs into a scalar of g, e.g. to derive the challenge
+// of a Fiat-Shamir proof. Each input is framed by its length, a 64-bit
+// big-endian integer, so that no two different lists of inputs hash the same
+// way. The hash of g is used if g is a HashFactory, such as the suites,
+// SHA-512 otherwise: its outputs for the counters 0, 1, ..., each prepended
+// as one byte to the framed inputs, are concatenated to 64 bytes, which are
+// reduced modulo the order with SetBytesWide. The result is thus close to
+// uniform for the groups whose order is much smaller than 2^512, such as the
+// elliptic curves. The inputs are written to the hash one after the other,
+// without building their encodings in memory. It only returns the errors of
+// the inputs' MarshalTo.
+func Scalar(g kyber.Group, inputs ...Framed) (kyber.Scalar, error) {
+	newHash := sha512.New
+	if f, ok := g.(kyber.HashFactory); ok {
+		newHash = f.Hash
+	}
+	var wide [64]byte
+	var length [8]byte
+	for counter, n := 0, 0; n < len(wide); counter++ {
+		h := newHash()
+		h.Write([]byte{byte(counter)})
+		for _, in := range inputs {
+			binary.BigEndian.PutUint64(length[:], uint64(in.MarshalSize()))
+			h.Write(length[:])
+			if _, err := in.MarshalTo(h); err != nil {
+				return nil, err
+			}
+		}
+		n += copy(wide[n:], h.Sum(nil))
+	}
+	return g.Scalar().SetBytesWide(wide), nil
+}
@@ -0,0 +1,69 @@
+package key
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// MuSigSuite represents the functionalities needed by the MuSig key
+// aggregation.
+type MuSigSuite interface {
+	Suite
+	kyber.HashFactory
+	kyber.CipherFactory
+}
+
+// AggregatePublicKeys returns the sum of the public keys. The result is only
+// safe to use as a multi-signature verification key if every key comes with a
+// proof of possession of its secret (see ProofOfPossession): otherwise a
+// participant can choose its key as Y - sum(others), a rogue key, and sign
+// alone for the aggregate.
+func AggregatePublicKeys(suite Suite, pubs []kyber.Point) kyber.Point {
+	agg := suite.Point().Null()
+	for _, p := range pubs {
+		agg.Add(agg, p)
+	}
+	return agg
+}
+
+// AggregatePublicKeysMuSig returns the MuSig aggregate of the public keys,
+// sum(a_i * X_i), where each coefficient a_i is derived from hashing the whole
+// key set together with X_i (see MuSigCoefficients). Since a rogue key changes
+// all the coefficients, no participant can steer the aggregate towards a key
+// it controls, so no proof of possession is required. The order of the keys
+// matters.
+func AggregatePublicKeysMuSig(suite MuSigSuite, pubs []kyber.Point) (kyber.Point, error) {
+	coeffs, err := MuSigCoefficients(suite, pubs)
+	if err != nil {
+		return nil, err
+	}
+	agg := suite.Point().Null()
+	for i, p := range pubs {
+		agg.Add(agg, suite.Point().Mul(coeffs[i], p))
+	}
+	return agg, nil
+}
+
+// MuSigCoefficients returns the MuSig coefficients a_i = H(L, X_i) of the
+// public keys, where L = H(X_1, ..., X_n) commits to the whole key set. Signers
+// multiply their secret key by their coefficient when signing for the
+// aggregate returned by AggregatePublicKeysMuSig.
+func MuSigCoefficients(suite MuSigSuite, pubs []kyber.Point) ([]kyber.Scalar, error) {
+	h := suite.Hash()
+	_, _ = h.Write([]byte("musig-keys"))
+	for _, p := range pubs {
+		if _, err := p.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	L := h.Sum(nil)
+	coeffs := make([]kyber.Scalar, len(pubs))
+	for i, p := range pubs {
+		h := suite.Hash()
+		_, _ = h.Write(L)
+		if _, err := p.MarshalTo(h); err != nil {
+			return nil, err
+		}
+		coeffs[i] = suite.Scalar().Pick(suite.Cipher(h.Sum(nil)))
+	}
+	return coeffs, nil
+}
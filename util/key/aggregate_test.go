@@ -0,0 +1,39 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregatePublicKeys(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	kp1 := NewKeyPair(suite)
+	kp2 := NewKeyPair(suite)
+	pubs := []kyber.Point{kp1.Public, kp2.Public}
+
+	sum := suite.Point().Add(kp1.Public, kp2.Public)
+	require.True(t, AggregatePublicKeys(suite, pubs).Equal(sum))
+
+	// the MuSig aggregate matches the aggregate secret key
+	coeffs, err := MuSigCoefficients(suite, pubs)
+	require.Nil(t, err)
+	secret := suite.Scalar().Mul(coeffs[0], kp1.Secret)
+	secret.Add(secret, suite.Scalar().Mul(coeffs[1], kp2.Secret))
+	agg, err := AggregatePublicKeysMuSig(suite, pubs)
+	require.Nil(t, err)
+	require.True(t, agg.Equal(suite.Point().Mul(secret, nil)))
+	require.False(t, agg.Equal(sum))
+
+	// a rogue key lets the attacker choose the plain aggregate, but not the
+	// MuSig one
+	target := NewKeyPair(suite)
+	rogue := suite.Point().Sub(target.Public, kp1.Public)
+	pubs = []kyber.Point{kp1.Public, rogue}
+	require.True(t, AggregatePublicKeys(suite, pubs).Equal(target.Public))
+	agg, err = AggregatePublicKeysMuSig(suite, pubs)
+	require.Nil(t, err)
+	require.False(t, agg.Equal(target.Public))
+}
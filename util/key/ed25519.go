@@ -0,0 +1,62 @@
+package key
+
+import (
+	"crypto/sha512"
+	"errors"
+	"strings"
+
+	"github.com/dedis/kyber"
+)
+
+var errorNotEd25519 = errors.New("key: not an edwards25519 key pair")
+var errorNoSeed = errors.New("key: Ed25519 seed of the key pair is unknown")
+var errorSeedSize = errors.New("key: Ed25519 seed must be 32 bytes long")
+
+// NewEd25519KeyPair returns the edwards25519 key pair of a 32-byte Ed25519
+// seed as defined in RFC 8032, such as the Seed of a crypto/ed25519 private
+// key. The pair keeps a copy of the seed for ExportEd25519Seed, so it holds
+// its secret twice and should only be used for keys meant to be exported.
+func NewEd25519KeyPair(suite Suite, seed []byte) (*Pair, error) {
+	if suite == nil || !isEd25519(suite) {
+		return nil, errorNotEd25519
+	}
+	if len(seed) != 32 {
+		return nil, errorSeedSize
+	}
+	kp := &Pair{Suite: suite, seed: append([]byte{}, seed...)}
+	kp.Secret = ed25519Secret(suite, kp.seed)
+	kp.Public = suite.Point().Mul(kp.Secret, nil)
+	return kp, nil
+}
+
+// ExportEd25519Seed returns the 32-byte Ed25519 seed of an edwards25519 key
+// pair, from which other Ed25519 implementations such as crypto/ed25519
+// derive the same key pair. Since the secret scalar is derived by hashing the
+// seed, the seed is only known for key pairs of NewEd25519KeyPair: key pairs
+// of NewKeyPair or Gen, decoded key pairs and key pairs whose Secret is
+// replaced can't be exported.
+func ExportEd25519Seed(kp *Pair) ([]byte, error) {
+	if kp.Suite == nil || !isEd25519(kp.Suite) {
+		return nil, errorNotEd25519
+	}
+	if kp.seed == nil || !ed25519Secret(kp.Suite, kp.seed).Equal(kp.Secret) {
+		return nil, errorNoSeed
+	}
+	return append([]byte{}, kp.seed...), nil
+}
+
+// isEd25519 tells whether the suite is one of the edwards25519 suites, whose
+// keys are compatible with Ed25519.
+func isEd25519(suite Suite) bool {
+	return strings.HasPrefix(suite.String(), "Ed25519") &&
+		suite.ScalarLen() == 32 && suite.PointLen() == 32
+}
+
+// ed25519Secret derives the secret scalar of an Ed25519 seed.
+func ed25519Secret(suite Suite, seed []byte) kyber.Scalar {
+	digest := sha512.Sum512(seed)
+	digest[0] &= 0xf8
+	digest[31] &= 0x3f
+	digest[31] |= 0x40
+	return suite.Scalar().SetBytes(digest[:32])
+}
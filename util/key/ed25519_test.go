@@ -0,0 +1,59 @@
+package key
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+// Ed25519 seeds and public keys of the test vectors of RFC 8032, section 7.1
+var ed25519Vectors = []struct {
+	seed   string
+	public string
+}{
+	{"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"},
+	{"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c"},
+	{"c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		"fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025"},
+	{"f5e5767cf153319517630f226876b86c8160cc583bc013744c6bf255f5cc0ee5",
+		"278117fc144c72340f67d0f2316e8386ceffbf2b2428c9c51fef7c597f1d426e"},
+}
+
+func TestEd25519KeyPairVectors(t *testing.T) {
+	for _, suite := range []Suite{
+		edwards25519.NewAES128SHA256Ed25519(),
+		edwards25519.NewAES256SHA256Ed25519(),
+	} {
+		for _, v := range ed25519Vectors {
+			seed, err := hex.DecodeString(v.seed)
+			require.Nil(t, err)
+			kp, err := NewEd25519KeyPair(suite, seed)
+			require.Nil(t, err)
+
+			pub, err := kp.Public.MarshalBinary()
+			require.Nil(t, err)
+			require.Equal(t, v.public, hex.EncodeToString(pub))
+
+			exported, err := ExportEd25519Seed(kp)
+			require.Nil(t, err)
+			require.Equal(t, seed, exported)
+		}
+	}
+}
+
+func TestNewEd25519KeyPairInvalid(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	_, err := NewEd25519KeyPair(suite, make([]byte, 31))
+	require.Equal(t, errorSeedSize, err)
+	_, err = NewEd25519KeyPair(nonEd25519{suite}, make([]byte, 32))
+	require.Equal(t, errorNotEd25519, err)
+}
+
+// nonEd25519 is a suite that isn't recognized as an edwards25519 suite.
+type nonEd25519 struct{ Suite }
+
+func (nonEd25519) String() string { return "other" }
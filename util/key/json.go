@@ -0,0 +1,98 @@
+package key
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/dedis/kyber/util/encoding"
+)
+
+// jsonPair is the JSON representation of a Pair. The points and scalars are
+// base64 encoded and the suite is identified by its name.
+type jsonPair struct {
+	Suite  string `json:"suite"`
+	Public string `json:"public"`
+	Secret string `json:"secret,omitempty"`
+}
+
+// MarshalJSON encodes the key pair in JSON together with the name of its
+// suite, for example:
+//
+//	{"suite":"Ed25519","public":"base64","secret":"base64"}
+func (p *Pair) MarshalJSON() ([]byte, error) {
+	jp, err := p.toJSON()
+	if err != nil {
+		return nil, err
+	}
+	if p.Secret != nil {
+		if jp.Secret, err = encoding.ScalarToString64(p.Suite, p.Secret); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(jp)
+}
+
+// UnmarshalJSON decodes a key pair encoded with MarshalJSON. The suite is
+// resolved by name in the registry, which importing the group package fills
+// with the suites it implements. The secret is
+// optional, in which case only the public key is set. It returns an error if
+// the secret does not correspond to the public key.
+func (p *Pair) UnmarshalJSON(data []byte) error {
+	jp := &jsonPair{}
+	if err := json.Unmarshal(data, jp); err != nil {
+		return err
+	}
+	suite, ok := registry.Lookup(jp.Suite)
+	if !ok {
+		return errors.New("key: no suite registered as " + jp.Suite)
+	}
+	public, err := encoding.String64ToPoint(suite, jp.Public)
+	if err != nil {
+		return err
+	}
+	var secret kyber.Scalar
+	if jp.Secret != "" {
+		secret, err = encoding.String64ToScalar(suite, jp.Secret)
+		if err != nil {
+			return err
+		}
+		if !suite.Point().Mul(secret, nil).Equal(public) {
+			return errorPairMismatch
+		}
+	}
+	p.Suite = suite
+	p.Public = public
+	p.Secret = secret
+	return nil
+}
+
+func (p *Pair) toJSON() (*jsonPair, error) {
+	public, err := encoding.PointToString64(p.Suite, p.Public)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonPair{Suite: p.Suite.String(), Public: public}, nil
+}
+
+// PublicPair is a view of a Pair that only exports its public part, so that
+// a key pair can be shared without leaking its secret.
+type PublicPair struct {
+	*Pair
+}
+
+// PublicPair returns the public view of this key pair.
+func (p *Pair) PublicPair() *PublicPair {
+	return &PublicPair{p}
+}
+
+// MarshalJSON encodes the public key of the pair in JSON together with the
+// name of its suite, omitting the secret.
+func (p *PublicPair) MarshalJSON() ([]byte, error) {
+	jp, err := p.toJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jp)
+}
@@ -0,0 +1,52 @@
+package key
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairJSON(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	registry.Register(suite)
+	kp := NewKeyPair(suite)
+
+	buff, err := json.Marshal(kp)
+	require.Nil(t, err)
+	require.True(t, strings.Contains(string(buff), `"suite":"Ed25519"`))
+
+	kp2 := &Pair{}
+	require.Nil(t, json.Unmarshal(buff, kp2))
+	require.Equal(t, suite.String(), kp2.Suite.String())
+	require.True(t, kp.Public.Equal(kp2.Public))
+	require.True(t, kp.Secret.Equal(kp2.Secret))
+
+	// secret and public keys must match
+	kp3 := NewKeyPair(suite)
+	kp3.Secret = kp.Secret
+	buff, err = json.Marshal(kp3)
+	require.Nil(t, err)
+	require.Error(t, json.Unmarshal(buff, &Pair{}))
+
+	// unknown suite
+	require.Error(t, json.Unmarshal([]byte(`{"suite":"unknown","public":""}`), &Pair{}))
+}
+
+func TestPublicPairJSON(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	registry.Register(suite)
+	kp := NewKeyPair(suite)
+
+	buff, err := json.Marshal(kp.PublicPair())
+	require.Nil(t, err)
+	require.False(t, strings.Contains(string(buff), "secret"))
+
+	kp2 := &Pair{}
+	require.Nil(t, json.Unmarshal(buff, kp2))
+	require.True(t, kp.Public.Equal(kp2.Public))
+	require.Nil(t, kp2.Secret)
+}
@@ -3,11 +3,15 @@ package key
 
 import (
 	"crypto/cipher"
+	"errors"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/util/random"
 )
 
+var errorPairIncomplete = errors.New("key: key pair without suite, public or secret key")
+var errorPairMismatch = errors.New("key: public and secret keys don't match")
+
 // Suite represents the list of functionalities needed by this package.
 type Suite kyber.Group
 
@@ -18,6 +22,9 @@ type Pair struct {
 	Public kyber.Point  // Public key
 	Secret kyber.Scalar // Secret key
 	Hiding kyber.Hiding // Hiding type of the public key
+
+	seed   []byte // Ed25519 seed of the secret key, see NewEd25519KeyPair
+	signer Signer // External holder of the secret key, if any
 }
 
 // NewKeyPair directly creates a secret/public key pair
@@ -27,6 +34,17 @@ func NewKeyPair(suite Suite) *Pair {
 	return kp
 }
 
+// NewKeyPairChecked provides the same functionality as NewKeyPair but first
+// runs the health check of the entropy source random.Stream reads from, see
+// random.SetSource. It refuses to generate a key and returns the error of the
+// health check if it fails.
+func NewKeyPairChecked(suite Suite) (*Pair, error) {
+	if err := random.HealthCheck(); err != nil {
+		return nil, err
+	}
+	return NewKeyPair(suite), nil
+}
+
 // NewHidingKeyPair creates a secret/public key pair and makes sure the
 // the public key is hiding-encodable under the field keypair.Hiding.
 func NewHidingKeyPair(suite Suite) *Pair {
@@ -35,25 +53,75 @@ func NewHidingKeyPair(suite Suite) *Pair {
 	return kp
 }
 
+// EphemeralKey picks a fresh non-zero scalar k from rand, or from
+// random.Stream if rand is nil, and returns it with the point kG, as for the
+// ephemeral keys of ElGamal.
+func EphemeralKey(suite Suite, rand cipher.Stream) (kyber.Scalar, kyber.Point) {
+	if rand == nil {
+		rand = random.Stream
+	}
+	zero := suite.Scalar().Zero()
+	k := suite.Scalar().Pick(rand)
+	for k.Equal(zero) {
+		k.Pick(rand)
+	}
+	return k, suite.Point().Mul(k, nil)
+}
+
 // Gen creates a fresh public/private keypair with the given ciphersuite,
 // using a given source of cryptographic randomness.
 func (p *Pair) Gen(suite Suite, random cipher.Stream) {
 	p.Suite = suite
+	p.seed = nil
+	p.signer = nil
 	p.Secret = suite.NewKey(random)
 	p.Public = suite.Point().Mul(p.Secret, nil)
 }
 
 // GenHiding tries to generate private / public key pair as long as the public
-// key is not hiding-encodable.
+// key is not hiding-encodable. For groups whose encoding is randomized, such as
+// edwards25519, a later HideEncode of the public key may still fail, and the
+// caller has to generate another key pair.
 func (p *Pair) GenHiding(suite Suite, rand cipher.Stream) {
-	p.Gen(suite, rand)
-	Xh := p.Public.(kyber.Hiding)
 	for {
-		Xb := Xh.HideEncode(rand) // try to encode as uniform blob
-		if Xb != nil {
+		p.Gen(suite, rand)
+		Xh := p.Public.(kyber.Hiding)
+		if Xb := Xh.HideEncode(rand); Xb != nil { // try to encode as uniform blob
 			p.Hiding = Xh
 			return // success
 		}
-		p.Gen(suite, rand)
 	}
 }
+
+// Validate checks that the key pair is complete and that its public key is
+// the one of its secret, e.g. after decoding the pair. Pairs whose secret is
+// unknown, such as the ones of NewSignerPair, are not valid.
+func (p *Pair) Validate() error {
+	if p.Suite == nil || p.Public == nil || p.Secret == nil {
+		return errorPairIncomplete
+	}
+	if !p.Suite.Point().Mul(p.Secret, nil).Equal(p.Public) {
+		return errorPairMismatch
+	}
+	return nil
+}
+
+// Equal returns whether both key pairs have the same suite, public key and
+// secret key, the secret being possibly unknown for both.
+func (p *Pair) Equal(other *Pair) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Suite == nil) != (other.Suite == nil) ||
+		(p.Public == nil) != (other.Public == nil) ||
+		(p.Secret == nil) != (other.Secret == nil) {
+		return false
+	}
+	if p.Suite != nil && p.Suite.String() != other.Suite.String() {
+		return false
+	}
+	if p.Public != nil && !p.Public.Equal(other.Public) {
+		return false
+	}
+	return p.Secret == nil || p.Secret.Equal(other.Secret)
+}
@@ -1,9 +1,13 @@
 package key
 
 import (
+	"crypto/cipher"
+	"errors"
 	"testing"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
 )
 
 func TestNewKeyPair(t *testing.T) {
@@ -14,3 +18,119 @@ func TestNewKeyPair(t *testing.T) {
 		t.Fatal("Public and private-key don't match")
 	}
 }
+
+func TestGenHiding(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	for i := 0; i < 10; i++ {
+		kp := NewHidingKeyPair(suite)
+		if P, ok := kp.Hiding.(kyber.Point); !ok || P != kp.Public {
+			t.Fatal("hiding encoding of another point than the public key")
+		}
+		if !suite.Point().Mul(kp.Secret, nil).Equal(kp.Public) {
+			t.Fatal("Public and private-key don't match")
+		}
+	}
+}
+
+type unhealthySource struct{}
+
+func (unhealthySource) Read(b []byte) (int, error) { return len(b), nil }
+
+func (unhealthySource) HealthCheck() error { return errors.New("source failed") }
+
+func TestNewKeyPairChecked(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	kp, err := NewKeyPairChecked(suite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !suite.Point().Mul(kp.Secret, nil).Equal(kp.Public) {
+		t.Fatal("Public and private-key don't match")
+	}
+
+	random.SetSource(unhealthySource{})
+	defer random.SetSource(nil)
+	if kp, err = NewKeyPairChecked(suite); err == nil || kp != nil {
+		t.Fatal("key generated from an unhealthy source")
+	}
+}
+
+func TestExportEd25519SeedUnknown(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	if _, err := ExportEd25519Seed(NewKeyPair(suite)); err != errorNoSeed {
+		t.Fatal("seed exported for a key pair of NewKeyPair")
+	}
+	kp, err := NewEd25519KeyPair(suite, random.Bytes(32, random.Stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ExportEd25519Seed(kp); err != nil {
+		t.Fatal(err)
+	}
+	kp.Secret = suite.Scalar().Pick(random.Stream)
+	if _, err := ExportEd25519Seed(kp); err != errorNoSeed {
+		t.Fatal("seed exported for a replaced secret")
+	}
+	if _, err := ExportEd25519Seed(&Pair{Suite: suite, Secret: kp.Secret}); err != errorNoSeed {
+		t.Fatal("seed exported for a key pair without seed")
+	}
+	if _, err := ExportEd25519Seed(&Pair{}); err != errorNotEd25519 {
+		t.Fatal("seed exported for a key pair without suite")
+	}
+}
+
+func TestValidateEqual(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	kp := NewKeyPair(suite)
+	if err := kp.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	same := &Pair{Suite: edwards25519.NewAES128SHA256Ed25519(), Public: kp.Public.Clone(), Secret: kp.Secret.Clone()}
+	if !kp.Equal(same) || !same.Equal(kp) {
+		t.Fatal("identical key pairs are not equal")
+	}
+
+	// the public key was tampered with
+	same.Public.Add(same.Public, suite.Point().Base())
+	if err := same.Validate(); err != errorPairMismatch {
+		t.Fatal("tampered public key passes validation:", err)
+	}
+	if kp.Equal(same) {
+		t.Fatal("key pairs with different public keys are equal")
+	}
+
+	other := NewKeyPair(suite)
+	other.Public = kp.Public
+	if kp.Equal(other) {
+		t.Fatal("key pairs with different secrets are equal")
+	}
+
+	public := &Pair{Suite: suite, Public: kp.Public}
+	if err := public.Validate(); err != errorPairIncomplete {
+		t.Fatal("key pair without secret passes validation:", err)
+	}
+	if kp.Equal(public) || !public.Equal(&Pair{Suite: suite, Public: kp.Public}) {
+		t.Fatal("wrong comparison of key pairs without secret")
+	}
+	if kp.Equal(nil) || !(*Pair)(nil).Equal(nil) {
+		t.Fatal("wrong comparison with a nil key pair")
+	}
+}
+
+func TestEphemeralKey(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	for _, rand := range []cipher.Stream{nil, random.Stream, suite.Cipher([]byte("seed"))} {
+		k, K := EphemeralKey(suite, rand)
+		if k.Equal(suite.Scalar().Zero()) {
+			t.Fatal("zero ephemeral key")
+		}
+		if !K.Equal(suite.Point().Mul(k, nil)) {
+			t.Fatal("ephemeral public key doesn't match")
+		}
+	}
+	k1, _ := EphemeralKey(suite, suite.Cipher([]byte("seed")))
+	k2, _ := EphemeralKey(suite, suite.Cipher([]byte("seed")))
+	if !k1.Equal(k2) {
+		t.Fatal("ephemeral key doesn't depend on the stream only")
+	}
+}
@@ -0,0 +1,86 @@
+package key
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/hash"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errorPoPSecret = errors.New("key: proof of possession needs the secret of the key pair")
+var errorPoPLength = errors.New("key: proof of possession of invalid length")
+var errorPoPEncoding = errors.New("key: non-canonical encoding of the proof of possession")
+var errorPoPInvalid = errors.New("key: invalid proof of possession")
+
+// popDomain separates the challenges of the proofs of possession from the
+// ones of other Schnorr signatures made with the same key.
+var popDomain = []byte("kyber-proof-of-possession")
+
+// ProofOfPossession returns a proof that the owner of the key pair knows its
+// secret: a Schnorr signature R || s of the public key itself, made with that
+// key. Requiring one from every participant before adding up their public keys,
+// as with AggregatePublicKeys, prevents rogue-key attacks. The proof needs the
+// Secret of the key pair, so it cannot be made for a key pair created by
+// NewSignerPair.
+func ProofOfPossession(kp *Pair) ([]byte, error) {
+	if kp.Secret == nil {
+		return nil, errorPoPSecret
+	}
+	suite := kp.Suite
+	k := suite.Scalar().Pick(random.Stream)
+	R := suite.Point().Mul(k, nil)
+	c, err := popChallenge(suite, kp.Public, R)
+	if err != nil {
+		return nil, err
+	}
+	s := suite.Scalar().MulAdd(c, kp.Secret, k)
+
+	var b bytes.Buffer
+	if _, err := R.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	if _, err := s.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// VerifyPoP checks that pop is a proof of possession of the secret of pub, as
+// made by ProofOfPossession. It returns nil iff the proof is valid.
+func VerifyPoP(suite Suite, pub kyber.Point, pop []byte) error {
+	R := suite.Point()
+	s := suite.Scalar()
+	pointSize := R.MarshalSize()
+	if len(pop) != pointSize+s.MarshalSize() {
+		return errorPoPLength
+	}
+	if err := R.UnmarshalBinary(pop[:pointSize]); err != nil {
+		return err
+	}
+	if err := s.UnmarshalBinary(pop[pointSize:]); err != nil {
+		return err
+	}
+	if buf, err := s.MarshalBinary(); err != nil || !bytes.Equal(buf, pop[pointSize:]) {
+		return errorPoPEncoding
+	}
+	c, err := popChallenge(suite, pub, R)
+	if err != nil {
+		return err
+	}
+
+	// sG == R + cX
+	sG := suite.Point().Mul(s, nil)
+	RcX := suite.Point().Add(R, suite.Point().Mul(c, pub))
+	if !sG.Equal(RcX) {
+		return errorPoPInvalid
+	}
+	return nil
+}
+
+// popChallenge derives the challenge of a proof of possession with
+// hash.Scalar of the domain, pub and R.
+func popChallenge(suite Suite, pub, R kyber.Point) (kyber.Scalar, error) {
+	return hash.Scalar(suite, hash.Raw(popDomain), pub, R)
+}
@@ -0,0 +1,36 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofOfPossession(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	kp := NewKeyPair(suite)
+
+	pop, err := ProofOfPossession(kp)
+	require.Nil(t, err)
+	require.Equal(t, suite.PointLen()+suite.ScalarLen(), len(pop))
+	require.Nil(t, VerifyPoP(suite, kp.Public, pop))
+
+	// made with a different secret
+	other := NewKeyPair(suite)
+	forged, err := ProofOfPossession(&Pair{Suite: suite, Public: kp.Public, Secret: other.Secret})
+	require.Nil(t, err)
+	require.Equal(t, errorPoPInvalid, VerifyPoP(suite, kp.Public, forged))
+
+	// a genuine proof for another key
+	otherPoP, err := ProofOfPossession(other)
+	require.Nil(t, err)
+	require.Equal(t, errorPoPInvalid, VerifyPoP(suite, kp.Public, otherPoP))
+
+	require.Equal(t, errorPoPLength, VerifyPoP(suite, kp.Public, pop[1:]))
+
+	hw := NewSignerPair(suite, &mockSigner{suite: suite, secret: suite.Scalar().Pick(random.Stream)})
+	_, err = ProofOfPossession(hw)
+	require.Equal(t, errorPoPSecret, err)
+}
@@ -0,0 +1,66 @@
+package key
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var errorSetSuites = errors.New("key: the key pairs of a set must share their suite")
+var errorSetIndex = errors.New("key: key pair indices of the set are not 0, 1, ...")
+var errorSetCount = errors.New("key: number of key pairs does not match the count of the set")
+
+// jsonSet is the JSON representation of a set of key pairs.
+type jsonSet struct {
+	Count int            `json:"count"`
+	Pairs []jsonSetEntry `json:"pairs"`
+}
+
+type jsonSetEntry struct {
+	Index int   `json:"index"`
+	Pair  *Pair `json:"pair"`
+}
+
+// SaveSet writes the key pairs, e.g. the keys of the trustees of a PVSS
+// committee, to w as a single JSON document holding their count and, for
+// each pair, its index in the slice and its encoding by MarshalJSON:
+//
+//	{"count":2,"pairs":[{"index":0,"pair":{...}},{"index":1,"pair":{...}}]}
+//
+// All the pairs must have the same suite. The secrets are included, so the
+// output must be protected accordingly.
+func SaveSet(w io.Writer, pairs []*Pair) error {
+	set := jsonSet{Count: len(pairs), Pairs: make([]jsonSetEntry, len(pairs))}
+	for i, p := range pairs {
+		if p == nil || p.Suite == nil || p.Suite.String() != pairs[0].Suite.String() {
+			return errorSetSuites
+		}
+		set.Pairs[i] = jsonSetEntry{i, p}
+	}
+	return json.NewEncoder(w).Encode(&set)
+}
+
+// LoadSet reads a set of key pairs written by SaveSet, in the order of their
+// indices. As for UnmarshalJSON, the suite must be in the registry of the
+// group/registry package. It returns an error if the count or the indices of
+// the pairs are inconsistent, or if the pairs don't share their suite.
+func LoadSet(r io.Reader) ([]*Pair, error) {
+	var set jsonSet
+	if err := json.NewDecoder(r).Decode(&set); err != nil {
+		return nil, err
+	}
+	if set.Count != len(set.Pairs) {
+		return nil, errorSetCount
+	}
+	pairs := make([]*Pair, len(set.Pairs))
+	for i, e := range set.Pairs {
+		if e.Index != i {
+			return nil, errorSetIndex
+		}
+		if e.Pair == nil || e.Pair.Suite == nil || e.Pair.Suite.String() != set.Pairs[0].Pair.Suite.String() {
+			return nil, errorSetSuites
+		}
+		pairs[i] = e.Pair
+	}
+	return pairs, nil
+}
@@ -0,0 +1,53 @@
+package key
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveLoadSet(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	registry.Register(suite)
+	n := 5
+	pairs := make([]*Pair, n)
+	for i := range pairs {
+		pairs[i] = NewKeyPair(suite)
+	}
+
+	var buf bytes.Buffer
+	require.Nil(t, SaveSet(&buf, pairs))
+	require.True(t, strings.HasPrefix(buf.String(), `{"count":5,"pairs":[{"index":0,`))
+	loaded, err := LoadSet(&buf)
+	require.Nil(t, err)
+	require.Equal(t, n, len(loaded))
+	for i, p := range loaded {
+		require.Equal(t, suite.String(), p.Suite.String())
+		require.True(t, pairs[i].Public.Equal(p.Public))
+		require.True(t, pairs[i].Secret.Equal(p.Secret))
+	}
+
+	// inconsistent sets
+	other := edwards25519.NewAES256SHA256Ed25519()
+	registry.Register(other)
+	mixed := []*Pair{pairs[0], NewKeyPair(other)}
+	require.Equal(t, errorSetSuites, SaveSet(&buf, mixed))
+	for _, c := range []struct {
+		set jsonSet
+		err error
+	}{
+		{jsonSet{2, []jsonSetEntry{{0, mixed[0]}, {1, mixed[1]}}}, errorSetSuites},
+		{jsonSet{2, []jsonSetEntry{{0, pairs[0]}, {2, pairs[1]}}}, errorSetIndex},
+		{jsonSet{3, []jsonSetEntry{{0, pairs[0]}, {1, pairs[1]}}}, errorSetCount},
+	} {
+		data, err := json.Marshal(&c.set)
+		require.Nil(t, err)
+		_, err = LoadSet(bytes.NewReader(data))
+		require.Equal(t, c.err, err)
+	}
+}
@@ -0,0 +1,50 @@
+package key
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// Signer performs the operations involving the secret key of a key pair,
+// so that the secret can live outside of the process, e.g. in a hardware
+// token or an HSM, and never be exposed as a kyber.Scalar.
+type Signer interface {
+	// Public returns the public key, i.e. the secret times the base point.
+	Public() kyber.Point
+	// Mul returns the secret times the given point, e.g. a Diffie-Hellman
+	// shared secret when point is the public key of a peer.
+	Mul(point kyber.Point) kyber.Point
+}
+
+// softwareSigner is the default Signer, holding the secret in memory.
+type softwareSigner struct {
+	suite  Suite
+	secret kyber.Scalar
+}
+
+func (s *softwareSigner) Public() kyber.Point {
+	return s.suite.Point().Mul(s.secret, nil)
+}
+
+func (s *softwareSigner) Mul(point kyber.Point) kyber.Point {
+	return s.suite.Point().Mul(s.secret, point)
+}
+
+// NewSignerPair returns a key pair whose secret is held by the signer. Its
+// Public key is the one of the signer and its Secret is nil, so the secret
+// operations must go through the Signer method of the key pair.
+func NewSignerPair(suite Suite, signer Signer) *Pair {
+	return &Pair{
+		Suite:  suite,
+		Public: signer.Public(),
+		signer: signer,
+	}
+}
+
+// Signer returns the Signer of the key pair: the one given to NewSignerPair,
+// or otherwise a software signer using the Secret of the key pair.
+func (p *Pair) Signer() Signer {
+	if p.signer != nil {
+		return p.signer
+	}
+	return &softwareSigner{p.Suite, p.Secret}
+}
@@ -0,0 +1,47 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSigner stands for a hardware token: it never hands out its secret.
+type mockSigner struct {
+	suite  Suite
+	secret kyber.Scalar
+	calls  int
+}
+
+func (m *mockSigner) Public() kyber.Point {
+	return m.suite.Point().Mul(m.secret, nil)
+}
+
+func (m *mockSigner) Mul(point kyber.Point) kyber.Point {
+	m.calls++
+	return m.suite.Point().Mul(m.secret, point)
+}
+
+func TestSignerDH(t *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	mock := &mockSigner{suite: suite, secret: suite.Scalar().Pick(random.Stream)}
+	hw := NewSignerPair(suite, mock)
+	require.Nil(t, hw.Secret)
+	require.True(t, hw.Public.Equal(mock.Public()))
+	require.True(t, hw.Signer() == Signer(mock))
+
+	sw := NewKeyPair(suite)
+	require.True(t, sw.Signer().Public().Equal(sw.Public))
+
+	dh1 := hw.Signer().Mul(sw.Public)
+	dh2 := sw.Signer().Mul(hw.Public)
+	require.True(t, dh1.Equal(dh2))
+	require.Equal(t, 1, mock.calls)
+
+	// regenerating the key pair drops the signer
+	hw.Gen(suite, random.Stream)
+	require.True(t, hw.Signer().Public().Equal(hw.Public))
+}
@@ -6,7 +6,9 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/binary"
+	"io"
 	"math/big"
+	"sync"
 )
 
 // Bits chooses a uniform random BigInt with a given maximum BitLen.
@@ -100,6 +102,58 @@ func NonZeroBytes(n int, rand cipher.Stream) []byte {
 	}
 }
 
+// EntropySource is a source of cryptographically strong random bits that can
+// report on its own health, such as a hardware generator running continuous
+// self-tests.
+type EntropySource interface {
+	io.Reader
+	// HealthCheck returns an error if the source must not be relied upon.
+	HealthCheck() error
+}
+
+// systemSource reads from crypto/rand and is always considered healthy.
+type systemSource struct{}
+
+func (systemSource) Read(b []byte) (int, error) { return rand.Read(b) }
+
+func (systemSource) HealthCheck() error { return nil }
+
+var source = struct {
+	sync.RWMutex
+	src EntropySource
+}{src: systemSource{}}
+
+// SetSource replaces the entropy source Stream reads from. A nil source
+// restores the default crypto/rand source. SetSource is safe to call
+// concurrently with readers of Stream and HealthCheck, but readers already in
+// progress may still complete using the former source.
+func SetSource(src EntropySource) {
+	if src == nil {
+		src = systemSource{}
+	}
+	source.Lock()
+	defer source.Unlock()
+	source.src = src
+}
+
+// Source returns the current entropy source, e.g. to restore it after a
+// temporary SetSource.
+func Source() EntropySource {
+	return currentSource()
+}
+
+// HealthCheck runs the health check of the current entropy source. It is safe
+// to call concurrently.
+func HealthCheck() error {
+	return currentSource().HealthCheck()
+}
+
+func currentSource() EntropySource {
+	source.RLock()
+	defer source.RUnlock()
+	return source.src
+}
+
 type randstream struct {
 }
 
@@ -110,7 +164,7 @@ func (r *randstream) XORKeyStream(dst, src []byte) {
 	}
 
 	buf := make([]byte, l)
-	n, err := rand.Read(buf)
+	n, err := io.ReadFull(currentSource(), buf)
 	if err != nil {
 		panic(err)
 	}
@@ -124,5 +178,6 @@ func (r *randstream) XORKeyStream(dst, src []byte) {
 }
 
 // Stream is the standard virtual "stream cipher" that just generates
-// fresh cryptographically strong random bits.
+// fresh cryptographically strong random bits from the source set with
+// SetSource, crypto/rand by default.
 var Stream cipher.Stream = new(randstream)
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+// contract reports a violation of the documented semantics of the Scalar or
+// Point interfaces, naming the group and the contract that was broken.
+type contract struct {
+	t *testing.T
+	g kyber.Group
+}
+
+func (c contract) check(ok bool, format string, args ...interface{}) {
+	if !ok {
+		args = append([]interface{}{c.g.String()}, args...)
+		c.t.Errorf("%s: contract violated: "+format, args...)
+	}
+}
+
+// ScalarContract checks that the scalars of g follow the documented semantics
+// of the kyber.Scalar interface: the setters and arithmetic methods return
+// their receiver, Set and Clone make independent copies, operands may alias
+// the receiver, and the usual arithmetic identities hold. It is meant to be
+// run against every new group implementation.
+func ScalarContract(t *testing.T, g kyber.Group) {
+	c := contract{t, g}
+	rand := random.Stream
+	a := g.Scalar().Pick(rand)
+	b := g.Scalar().Pick(rand)
+	s := g.Scalar()
+
+	// setters and operations return the receiver
+	c.check(s.Set(a) == s, "Set must return its receiver")
+	c.check(s.Zero() == s, "Zero must return its receiver")
+	c.check(s.One() == s, "One must return its receiver")
+	c.check(s.SetInt64(3) == s, "SetInt64 must return its receiver")
+	c.check(s.Pick(rand) == s, "Pick must return its receiver")
+	c.check(s.SetBytes([]byte{1}) == s, "SetBytes must return its receiver")
+	c.check(s.Add(a, b) == s, "Add must return its receiver")
+	c.check(s.Sub(a, b) == s, "Sub must return its receiver")
+	c.check(s.Neg(a) == s, "Neg must return its receiver")
+	c.check(s.Mul(a, b) == s, "Mul must return its receiver")
+	c.check(s.MulAdd(a, b, a) == s, "MulAdd must return its receiver")
+
+	// copies are independent
+	s.Set(a)
+	c.check(s.Equal(a), "Set must copy the value")
+	s.Add(s, g.Scalar().One())
+	c.check(!s.Equal(a), "modifying a scalar after Set must not modify its source")
+	cl := a.Clone()
+	c.check(cl.Equal(a), "Clone must copy the value")
+	cl.Add(cl, g.Scalar().One())
+	c.check(!cl.Equal(a), "modifying a clone must not modify the original")
+
+	// operands may alias the receiver
+	two := g.Scalar().SetInt64(2)
+	s.Set(a)
+	c.check(s.Add(s, s).Equal(g.Scalar().Mul(two, a)), "Add(s, s) must equal 2s")
+	s.Set(a)
+	c.check(s.Mul(s, s).Equal(g.Scalar().Mul(a, a)), "Mul(s, s) must equal s*s")
+	s.Set(a)
+	c.check(s.Sub(s, s).IsZero(), "Sub(s, s) must be zero")
+	s.Set(a)
+	c.check(s.Neg(s).Equal(g.Scalar().Neg(a)), "Neg(s) must work in place")
+
+	// zero and one
+	zero := g.Scalar().Zero()
+	one := g.Scalar().One()
+	c.check(zero.IsZero() && !zero.IsOne(), "Zero must be zero and not one")
+	c.check(one.IsOne() && !one.IsZero(), "One must be one and not zero")
+	c.check(g.Scalar().SetInt64(0).Equal(zero), "SetInt64(0) must equal Zero")
+	c.check(g.Scalar().SetInt64(1).Equal(one), "SetInt64(1) must equal One")
+
+	// arithmetic identities
+	c.check(g.Scalar().Add(a, zero).Equal(a), "a+0 must equal a")
+	c.check(g.Scalar().Mul(a, one).Equal(a), "a*1 must equal a")
+	c.check(g.Scalar().Mul(a, zero).IsZero(), "a*0 must be zero")
+	c.check(g.Scalar().Add(a, g.Scalar().Neg(a)).IsZero(), "a+(-a) must be zero")
+	c.check(g.Scalar().Add(a, b).Equal(g.Scalar().Add(b, a)), "Add must be commutative")
+	c.check(g.Scalar().Mul(a, b).Equal(g.Scalar().Mul(b, a)), "Mul must be commutative")
+	c.check(g.Scalar().Sub(g.Scalar().Add(a, b), b).Equal(a), "(a+b)-b must equal a")
+	ab := g.Scalar().Add(a, b)
+	c.check(g.Scalar().Mul(ab, a).Equal(g.Scalar().Add(g.Scalar().Mul(a, a), g.Scalar().Mul(b, a))),
+		"Mul must distribute over Add")
+	c.check(g.Scalar().MulAdd(a, b, a).Equal(g.Scalar().Add(g.Scalar().Mul(a, b), a)),
+		"MulAdd(a, b, c) must equal a*b+c")
+	c.check(g.Scalar().SetInt64(-1).Equal(g.Scalar().Neg(one)), "SetInt64(-1) must equal -1")
+	if g.PrimeOrder() {
+		c.check(g.Scalar().Mul(a, g.Scalar().Inv(a)).IsOne(), "a*a^-1 must be one")
+		c.check(g.Scalar().Mul(g.Scalar().Div(a, b), b).Equal(a), "(a/b)*b must equal a")
+	}
+
+	// encoding
+	buf, err := a.MarshalBinary()
+	c.check(err == nil, "MarshalBinary must not fail: %v", err)
+	c.check(len(buf) == a.MarshalSize() && len(buf) == g.ScalarLen(),
+		"MarshalBinary must produce ScalarLen bytes")
+	dec := g.Scalar()
+	err = dec.UnmarshalBinary(buf)
+	c.check(err == nil && dec.Equal(a), "UnmarshalBinary must invert MarshalBinary")
+}
+
+// PointContract checks that the points of g follow the documented semantics
+// of the kyber.Point interface: the setters and arithmetic methods return
+// their receiver, Set and Clone make independent copies, operands may alias
+// the receiver, and the group laws hold. It is meant to be run against every
+// new group implementation.
+func PointContract(t *testing.T, g kyber.Group) {
+	c := contract{t, g}
+	rand := random.Stream
+	a := g.Scalar().Pick(rand)
+	b := g.Scalar().Pick(rand)
+	P := g.Point().Pick(rand)
+	Q := g.Point().Pick(rand)
+	R := g.Point()
+	null := g.Point().Null()
+
+	// setters and operations return the receiver
+	c.check(R.Null() == R, "Null must return its receiver")
+	c.check(R.Base() == R, "Base must return its receiver")
+	c.check(R.Pick(rand) == R, "Pick must return its receiver")
+	c.check(R.Set(P) == R, "Set must return its receiver")
+	c.check(R.Add(P, Q) == R, "Add must return its receiver")
+	c.check(R.Sub(P, Q) == R, "Sub must return its receiver")
+	c.check(R.Neg(P) == R, "Neg must return its receiver")
+	c.check(R.Mul(a, P) == R, "Mul must return its receiver")
+	c.check(R.Mul(a, nil) == R, "Mul with the base point must return its receiver")
+
+	// copies are independent
+	R.Set(P)
+	c.check(R.Equal(P), "Set must copy the value")
+	R.Add(R, g.Point().Base())
+	c.check(!R.Equal(P), "modifying a point after Set must not modify its source")
+	cl := P.Clone()
+	c.check(cl.Equal(P), "Clone must copy the value")
+	cl.Add(cl, g.Point().Base())
+	c.check(!cl.Equal(P), "modifying a clone must not modify the original")
+
+	// operands may alias the receiver
+	two := g.Scalar().SetInt64(2)
+	R.Set(P)
+	c.check(R.Add(R, R).Equal(g.Point().Mul(two, P)), "Add(P, P) must equal 2P")
+	R.Set(P)
+	c.check(R.Sub(R, R).Equal(null), "Sub(P, P) must be the neutral element")
+	R.Set(P)
+	c.check(R.Neg(R).Equal(g.Point().Neg(P)), "Neg(P) must work in place")
+	R.Set(P)
+	c.check(R.Mul(a, R).Equal(g.Point().Mul(a, P)), "Mul(a, P) must work in place")
+
+	// group laws
+	c.check(g.Point().Add(P, null).Equal(P), "P+0 must equal P")
+	c.check(g.Point().Add(P, g.Point().Neg(P)).Equal(null), "P+(-P) must be the neutral element")
+	c.check(g.Point().Add(P, Q).Equal(g.Point().Add(Q, P)), "Add must be commutative")
+	c.check(g.Point().Sub(g.Point().Add(P, Q), Q).Equal(P), "(P+Q)-Q must equal P")
+	c.check(g.Point().Mul(g.Scalar().Zero(), P).Equal(null), "0*P must be the neutral element")
+	c.check(g.Point().Mul(g.Scalar().One(), P).Equal(P), "1*P must equal P")
+	c.check(g.Point().Mul(a, nil).Equal(g.Point().Mul(a, g.Point().Base())),
+		"Mul(a, nil) must equal Mul(a, Base())")
+	c.check(g.Point().Mul(g.Scalar().Add(a, b), P).Equal(g.Point().Add(g.Point().Mul(a, P), g.Point().Mul(b, P))),
+		"(a+b)P must equal aP+bP")
+	c.check(g.Point().Mul(a, g.Point().Mul(b, P)).Equal(g.Point().Mul(g.Scalar().Mul(a, b), P)),
+		"a(bP) must equal (ab)P")
+	c.check(g.Point().MulInt(3, P).Equal(g.Point().Mul(g.Scalar().SetInt64(3), P)), "MulInt(3, P) must equal 3P")
+
+	// encoding
+	buf, err := P.MarshalBinary()
+	c.check(err == nil, "MarshalBinary must not fail: %v", err)
+	c.check(len(buf) == P.MarshalSize() && len(buf) == g.PointLen(),
+		"MarshalBinary must produce PointLen bytes")
+	dec := g.Point()
+	err = dec.UnmarshalBinary(buf)
+	c.check(err == nil && dec.Equal(P), "UnmarshalBinary must invert MarshalBinary")
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"testing"
+
+	"github.com/dedis/kyber"
+)
+
+// errExhausted is the panic value of exhaustedStream.
+const errExhausted = "exhausted stream"
+
+// exhaustedStream is a stream with no entropy left: drawing from it panics.
+type exhaustedStream struct{}
+
+func (exhaustedStream) XORKeyStream(dst, src []byte) {
+	panic(errExhausted)
+}
+
+// seededStream returns a deterministic stream, AES-CTR keyed with the seed.
+func seededStream(seed byte) cipher.Stream {
+	key := make([]byte, 16)
+	key[0] = seed
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	return cipher.NewCTR(block, make([]byte, aes.BlockSize))
+}
+
+// PickDeterminism checks that Scalar.Pick, Point.Pick, Point.Embed and NewKey
+// of g only draw from the stream they are given: with the same seeded stream
+// they must return the same values, and with an exhausted stream they must
+// fail instead of falling back to another source of randomness.
+func PickDeterminism(t *testing.T, g kyber.Group) {
+	ops := []struct {
+		name string
+		pick func(rand cipher.Stream) interface{}
+	}{
+		{"Scalar.Pick", func(rand cipher.Stream) interface{} { return g.Scalar().Pick(rand) }},
+		{"Point.Pick", func(rand cipher.Stream) interface{} { return g.Point().Pick(rand) }},
+		{"Point.Embed", func(rand cipher.Stream) interface{} { return g.Point().Embed([]byte("data"), rand) }},
+		{"NewKey", func(rand cipher.Stream) interface{} { return g.NewKey(rand) }},
+	}
+	for _, op := range ops {
+		func() {
+			defer func() {
+				if r := recover(); r != errExhausted {
+					t.Errorf("%s: %s doesn't draw from its stream", g.String(), op.name)
+				}
+			}()
+			op.pick(exhaustedStream{})
+		}()
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s: %s panicked: %v", g.String(), op.name, r)
+				}
+			}()
+			a := op.pick(seededStream(1))
+			b := op.pick(seededStream(1))
+			c := op.pick(seededStream(2))
+			equal := func(x, y interface{}) bool {
+				if s, ok := x.(kyber.Scalar); ok {
+					return s.Equal(y.(kyber.Scalar))
+				}
+				return x.(kyber.Point).Equal(y.(kyber.Point))
+			}
+			if !equal(a, b) {
+				t.Errorf("%s: %s is not deterministic for a given stream", g.String(), op.name)
+			}
+			if equal(a, c) {
+				t.Errorf("%s: %s ignores its stream", g.String(), op.name)
+			}
+		}()
+	}
+}
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickDeterminism(t *testing.T) {
+	PickDeterminism(t, edwards25519.NewAES128SHA256Ed25519())
+}
+
+func TestExhaustedStream(t *testing.T) {
+	require.Panics(t, func() { exhaustedStream{}.XORKeyStream(make([]byte, 1), make([]byte, 1)) })
+}
@@ -0,0 +1,35 @@
+package test
+
+import (
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/util/random"
+)
+
+// DistKeyShare simulates the output of a distributed key generation for one
+// share holder. It satisfies the DistKeyShare interfaces of the threshold
+// schemes, such as share/threshold.
+type DistKeyShare struct {
+	Pri     *share.PriShare
+	Commits []kyber.Point
+}
+
+// PriShare returns the private share of the share holder.
+func (d *DistKeyShare) PriShare() *share.PriShare { return d.Pri }
+
+// Commitments returns the commitments of the distributed key polynomial.
+func (d *DistKeyShare) Commitments() []kyber.Point { return d.Commits }
+
+// NewDistKeyShares shares a random key among n share holders with threshold
+// t, as a distributed key generation would. It returns the shares and the
+// public commitment polynomial, whose Commit is the distributed public key.
+func NewDistKeyShares(g kyber.Group, t, n int) ([]*DistKeyShare, *share.PubPoly) {
+	priPoly := share.NewPriPoly(g, t, nil, random.Stream)
+	pubPoly := priPoly.Commit(nil)
+	_, commits := pubPoly.Info()
+	dks := make([]*DistKeyShare, n)
+	for i, s := range priPoly.Shares(n) {
+		dks[i] = &DistKeyShare{s, commits}
+	}
+	return dks, pubPoly
+}
@@ -5,6 +5,13 @@ import (
 	"github.com/dedis/kyber/util/random"
 )
 
+// compressing is implemented by points having a compressed encoding besides
+// the one of MarshalBinary.
+type compressing interface {
+	MarshalCompressed() ([]byte, error)
+	UnmarshalCompressed(buf []byte) error
+}
+
 // GroupBench is a generic benchmark suite for kyber.groups.
 type GroupBench struct {
 	g kyber.Group
@@ -14,6 +21,7 @@ type GroupBench struct {
 	X, Y kyber.Point
 	xe   []byte // encoded Scalar
 	Xe   []byte // encoded Point
+	Xc   []byte // compressed encoded Point, if supported
 }
 
 // NewGroupBench returns a new GroupBench.
@@ -26,6 +34,9 @@ func NewGroupBench(g kyber.Group) *GroupBench {
 	gb.X = g.Point().Pick(random.Stream)
 	gb.Y = g.Point().Pick(random.Stream)
 	gb.Xe, _ = gb.X.MarshalBinary()
+	if c, ok := gb.X.(compressing); ok {
+		gb.Xc, _ = c.MarshalCompressed()
+	}
 	return &gb
 }
 
@@ -57,6 +68,13 @@ func (gb GroupBench) ScalarMul(iters int) {
 	}
 }
 
+// ScalarMulAdd benchmarks the fused multiply-add operation for scalars
+func (gb GroupBench) ScalarMulAdd(iters int) {
+	for i := 1; i < iters; i++ {
+		gb.x.MulAdd(gb.x, gb.y, gb.y)
+	}
+}
+
 // ScalarDiv benchmarks the division operation for scalars
 func (gb GroupBench) ScalarDiv(iters int) {
 	for i := 1; i < iters; i++ {
@@ -120,6 +138,14 @@ func (gb GroupBench) PointMul(iters int) {
 	}
 }
 
+// PointMulNew benchmarks the multiplication operation for points into a newly
+// allocated point, the baseline of PointMul, which reuses its receiver
+func (gb GroupBench) PointMulNew(iters int) {
+	for i := 1; i < iters; i++ {
+		gb.X = gb.g.Point().Mul(gb.y, gb.X)
+	}
+}
+
 // PointBaseMul benchmarks the base multiplication operation for points
 func (gb GroupBench) PointBaseMul(iters int) {
 	for i := 1; i < iters; i++ {
@@ -147,3 +173,28 @@ func (gb GroupBench) PointDecode(iters int) {
 		_ = gb.X.UnmarshalBinary(gb.Xe)
 	}
 }
+
+// PointEncodeCompressed benchmarks the compressed encoding operation for
+// points. It does nothing for groups without compressed encodings.
+func (gb GroupBench) PointEncodeCompressed(iters int) {
+	c, ok := gb.X.(compressing)
+	if !ok {
+		return
+	}
+	for i := 1; i < iters; i++ {
+		_, _ = c.MarshalCompressed()
+	}
+}
+
+// PointDecodeCompressed benchmarks the compressed decoding operation for
+// points, which involves a square root. It does nothing for groups without
+// compressed encodings.
+func (gb GroupBench) PointDecodeCompressed(iters int) {
+	c, ok := gb.X.(compressing)
+	if !ok {
+		return
+	}
+	for i := 1; i < iters; i++ {
+		_ = c.UnmarshalCompressed(gb.Xc)
+	}
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share/pvss"
+	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/random"
+)
+
+// NewPVSSSetup generates a committee of n trustees for a PVSS run with
+// threshold t. It returns a random base point H, the key pairs of the trustees
+// and their public keys, i.e. everything needed by pvss.EncShares. It panics if
+// the threshold is not in the range [1, n].
+func NewPVSSSetup(suite kyber.Group, n, t int) (H kyber.Point, keys []*key.Pair, X []kyber.Point) {
+	if t < 1 || t > n {
+		panic("invalid PVSS threshold")
+	}
+	H = suite.Point().Pick(random.Stream)
+	keys = make([]*key.Pair, n)
+	X = make([]kyber.Point, n)
+	for i := range keys {
+		keys[i] = key.NewKeyPair(suite)
+		X[i] = keys[i].Public
+	}
+	return H, keys, X
+}
+
+// PVSSBench is a benchmark suite for a full PVSS run with a committee of n
+// trustees and threshold t, to size committees for a given suite. It measures
+// the distribution of the shares by the dealer (EncShares), their
+// verification by a third party (VerifyBatch), their decryption by all the
+// trustees (DecBatch) and the recovery of the secret from t decrypted shares
+// (Recover). Each of the operations runs on shares prepared beforehand, so
+// the benchmarks can run in any order.
+type PVSSBench struct {
+	suite pvss.Suite
+	t, n  int
+
+	H         kyber.Point
+	keys      []*key.Pair
+	X         []kyber.Point
+	secret    kyber.Scalar
+	encShares []*pvss.PubVerShare
+	sH        []kyber.Point
+	decShares []*pvss.PubVerShare
+}
+
+// NewPVSSBench returns a new PVSSBench for a committee of n trustees and
+// threshold t. It panics if the threshold is not in the range [1, n] or if the
+// PVSS run fails.
+func NewPVSSBench(suite pvss.Suite, t, n int) *PVSSBench {
+	pb := &PVSSBench{suite: suite, t: t, n: n}
+	pb.H, pb.keys, pb.X = NewPVSSSetup(suite, n, t)
+	pb.secret = suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := pvss.EncShares(suite, pb.H, pb.X, pb.secret, t)
+	if err != nil {
+		panic(err)
+	}
+	pb.encShares = encShares
+	pb.sH = make([]kyber.Point, n)
+	for i := range pb.sH {
+		pb.sH[i] = pubPoly.Eval(encShares[i].S.I).V
+	}
+	pb.decShares = pb.decrypt()
+	return pb
+}
+
+// EncShares benchmarks the distribution of a secret by the dealer.
+func (pb *PVSSBench) EncShares(iters int) {
+	for i := 0; i < iters; i++ {
+		if _, _, err := pvss.EncShares(pb.suite, pb.H, pb.X, pb.secret, pb.t); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// VerifyBatch benchmarks the verification of all the encrypted shares.
+func (pb *PVSSBench) VerifyBatch(iters int) {
+	for i := 0; i < iters; i++ {
+		if _, _, err := pvss.VerifyEncShareBatch(pb.suite, pb.H, pb.X, pb.sH, pb.encShares); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// DecBatch benchmarks the decryption of their share by all the trustees.
+func (pb *PVSSBench) DecBatch(iters int) {
+	for i := 0; i < iters; i++ {
+		pb.decrypt()
+	}
+}
+
+// Recover benchmarks the verification of t decrypted shares and the recovery
+// of the secret from them.
+func (pb *PVSSBench) Recover(iters int) {
+	G := pb.suite.Point().Base()
+	t := pb.t
+	for i := 0; i < iters; i++ {
+		if _, err := pvss.RecoverSecret(pb.suite, G, pb.X[:t], pb.encShares[:t], pb.decShares[:t], t, pb.n); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (pb *PVSSBench) decrypt() []*pvss.PubVerShare {
+	decShares := make([]*pvss.PubVerShare, pb.n)
+	for i, kp := range pb.keys {
+		var err error
+		decShares[i], err = pvss.DecShare(pb.suite, pb.H, pb.X[i], pb.sH[i], kp.Secret, pb.encShares[i])
+		if err != nil {
+			panic(err)
+		}
+	}
+	return decShares
+}
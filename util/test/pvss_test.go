@@ -0,0 +1,48 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/share/pvss"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPVSSSetup(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	n := 7
+	t := 5
+	H, keys, X := NewPVSSSetup(suite, n, t)
+	require.Equal(test, n, len(keys))
+	require.Equal(test, n, len(X))
+
+	secret := suite.Scalar().Pick(random.Stream)
+	encShares, pubPoly, err := pvss.EncShares(suite, H, X, secret, t)
+	require.Nil(test, err)
+
+	var K []kyber.Point
+	var E, D []*pvss.PubVerShare
+	for i := 0; i < n; i++ {
+		sH := pubPoly.Eval(encShares[i].S.I).V
+		ds, err := pvss.DecShare(suite, H, X[i], sH, keys[i].Secret, encShares[i])
+		require.Nil(test, err)
+		K = append(K, X[i])
+		E = append(E, encShares[i])
+		D = append(D, ds)
+	}
+	recovered, err := pvss.RecoverSecret(suite, suite.Point().Base(), K, E, D, t, n)
+	require.Nil(test, err)
+	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
+}
+
+func TestPVSSBench(test *testing.T) {
+	suite := edwards25519.NewAES128SHA256Ed25519()
+	pb := NewPVSSBench(suite, 3, 4)
+	pb.EncShares(1)
+	pb.VerifyBatch(1)
+	pb.DecBatch(1)
+	pb.Recover(1)
+	require.Equal(test, 4, len(pb.decShares))
+}
@@ -111,6 +111,158 @@ func testScalarClone(g kyber.Group, rand cipher.Stream) {
 	}
 }
 
+func testScalarIdentities(g kyber.Group, rand cipher.Stream) {
+	zero := g.Scalar().Zero()
+	one := g.Scalar().One()
+	if !zero.IsZero() || zero.IsOne() {
+		panic("IsZero/IsOne wrong on Zero()")
+	}
+	if !one.IsOne() || one.IsZero() {
+		panic("IsZero/IsOne wrong on One()")
+	}
+	if !g.Scalar().Sub(one, one).IsZero() {
+		panic("1 - 1 is not zero")
+	}
+	if !g.Scalar().Mul(one, one).IsOne() {
+		panic("1 * 1 is not one")
+	}
+	for i := 0; i < 100; i++ {
+		s := g.Scalar().Pick(rand)
+		if s.IsZero() != s.Equal(zero) || s.IsOne() != s.Equal(one) {
+			panic("IsZero/IsOne disagree with Equal")
+		}
+	}
+}
+
+func testScalarMulAdd(g kyber.Group, rand cipher.Stream) {
+	for i := 0; i < 100; i++ {
+		a := g.Scalar().Pick(rand)
+		b := g.Scalar().Pick(rand)
+		c := g.Scalar().Pick(rand)
+		want := g.Scalar().Mul(a, b)
+		want.Add(want, c)
+		if !g.Scalar().MulAdd(a, b, c).Equal(want) {
+			panic("MulAdd differs from Mul and Add")
+		}
+		// the target may alias any of the operands
+		if !c.MulAdd(a, b, c).Equal(want) {
+			panic("MulAdd wrong when the target is an operand")
+		}
+	}
+}
+
+func testPointMulInt(g kyber.Group, rand cipher.Stream) {
+	P := g.Point().Pick(rand)
+	P3 := g.Point().Add(P, P)
+	P3.Add(P3, P)
+	if !g.Point().MulInt(3, P).Equal(P3) {
+		panic("MulInt(3, P) != P+P+P")
+	}
+	if !g.Point().MulInt(0, P).Equal(g.Point().Null()) {
+		panic("MulInt(0, P) != 0")
+	}
+	if !g.Point().MulInt(-3, P).Equal(g.Point().Neg(P3)) {
+		panic("MulInt(-3, P) != -(P+P+P)")
+	}
+	for _, k := range []int64{1, 2, 7, 8, 1000, -1 << 40} {
+		s := g.Scalar().SetInt64(k)
+		if !g.Point().MulInt(k, P).Equal(g.Point().Mul(s, P)) {
+			panic("MulInt and Mul differ")
+		}
+		if !g.Point().MulInt(k, nil).Equal(g.Point().Mul(s, nil)) {
+			panic("MulInt and Mul differ on the base point")
+		}
+	}
+	Q := P.Clone()
+	if !Q.MulInt(5, Q).Equal(g.Point().Mul(g.Scalar().SetInt64(5), P)) {
+		panic("MulInt wrong when the target is the operand")
+	}
+}
+
+// testPointMulReceiver checks that Mul gives the same result into a fresh
+// point, a reused receiver and the operand itself.
+func testPointMulReceiver(g kyber.Group, rand cipher.Stream) {
+	P := g.Point().Pick(rand)
+	dst := g.Point().Pick(rand)
+	for i := 0; i < 5; i++ {
+		s := g.Scalar().Pick(rand)
+		want := g.Point().Mul(s, P)
+		if !dst.Mul(s, P).Equal(want) {
+			panic("Mul into a reused receiver differs")
+		}
+		if !dst.Mul(s, nil).Equal(g.Point().Mul(s, nil)) {
+			panic("Mul of the base point into a reused receiver differs")
+		}
+		Q := P.Clone()
+		if !Q.Mul(s, Q).Equal(want) {
+			panic("Mul wrong when the target is the operand")
+		}
+	}
+}
+
+// testPointNullEncoding checks that the neutral element has a single encoding,
+// whether set with Null or obtained by arithmetic, and that it decodes back to
+// the neutral element, both as a byte slice and from a stream.
+func testPointNullEncoding(g kyber.Group, rand cipher.Stream) {
+	null := g.Point().Null()
+	buf, err := null.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) != g.PointLen() {
+		panic("neutral element encoding has the wrong length")
+	}
+	P := g.Point().Pick(rand)
+	for _, Q := range []kyber.Point{
+		g.Point().Sub(P, P),
+		g.Point().Add(P, g.Point().Neg(P)),
+		g.Point().Mul(g.Scalar().Zero(), P),
+		g.Point().Mul(g.Scalar().Zero(), nil),
+	} {
+		b, err := Q.MarshalBinary()
+		if err != nil {
+			panic(err)
+		}
+		if !bytes.Equal(buf, b) {
+			panic("computed neutral element has a different encoding")
+		}
+	}
+	Q := g.Point().Pick(rand)
+	if err := Q.UnmarshalBinary(buf); err != nil {
+		panic("neutral element encoding doesn't decode: " + err.Error())
+	}
+	if !Q.Equal(null) {
+		panic("neutral element encoding decodes to another point")
+	}
+	Q.Pick(rand)
+	if _, err := Q.UnmarshalFrom(bytes.NewReader(buf)); err != nil || !Q.Equal(null) {
+		panic("neutral element doesn't decode from a stream")
+	}
+}
+
+func testScalarIsCanonical(g kyber.Group, rand cipher.Stream) {
+	for i := 0; i < 100; i++ {
+		b, err := g.Scalar().Pick(rand).MarshalBinary()
+		if err != nil {
+			panic(err)
+		}
+		if !g.Scalar().IsCanonical(b) {
+			panic("marshaled scalar not canonical")
+		}
+	}
+	b, _ := g.Scalar().Zero().MarshalBinary()
+	if !g.Scalar().IsCanonical(b) || g.Scalar().IsCanonical(b[1:]) {
+		panic("IsCanonical doesn't check the length")
+	}
+	// all ones is at least the order, which fits in the same number of bytes
+	for i := range b {
+		b[i] = 0xff
+	}
+	if g.Scalar().IsCanonical(b) {
+		panic("IsCanonical accepted an unreduced encoding")
+	}
+}
+
 // Apply a generic set of validation tests to a cryptographic Group,
 // using a given source of [pseudo-]randomness.
 //
@@ -139,6 +291,19 @@ func testGroup(g kyber.Group, rand cipher.Stream) []kyber.Point {
 	gen := g.Point().Base()
 	points = append(points, gen)
 
+	// The generator is the fixed base point and has the order of the group:
+	// (q-1)*G + G == 0 with G != 0
+	if !g.Generator().Equal(gen) {
+		panic("oops, generator is not the base point")
+	}
+	if g.Generator().Equal(pzero) {
+		panic("oops, generator is the neutral element")
+	}
+	ptmp.Mul(stmp.SetInt64(-1), g.Generator()).Add(ptmp, g.Generator())
+	if !ptmp.Equal(pzero) {
+		panic("oops, generator doesn't have the group order")
+	}
+
 	// Sanity-check relationship between addition and multiplication
 	p1 := g.Point().Add(gen, gen)
 	p2 := g.Point().Mul(stmp.SetInt64(2), nil)
@@ -305,6 +470,12 @@ func testGroup(g kyber.Group, rand cipher.Stream) []kyber.Point {
 	testPointClone(g, rand)
 	testScalarSet(g, rand)
 	testScalarClone(g, rand)
+	testScalarIdentities(g, rand)
+	testScalarMulAdd(g, rand)
+	testPointMulInt(g, rand)
+	testPointMulReceiver(g, rand)
+	testScalarIsCanonical(g, rand)
+	testPointNullEncoding(g, rand)
 
 	return points
 }
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+// timingLeakThreshold is the relative difference between the median running
+// times over low and high Hamming weight secrets from which TimingLeakCheck
+// reports a leak. It is large enough not to be tripped by the noise of a
+// loaded machine, so only gross leaks are caught.
+const timingLeakThreshold = 0.5
+
+// timingLeakRounds is the number of times each secret is timed.
+const timingLeakRounds = 5
+
+// TimingLeakCheck is a crude, best-effort check that the running time of op
+// doesn't depend on its secret input. It splits the secrets into the half
+// with the lowest Hamming weight and the half with the highest, times op on
+// both halves alternately, and fails the test if the median running times
+// differ by more than half. Such a test can't prove an operation constant
+// time, but it catches gross leaks, like a double-and-add scalar
+// multiplication skipping the additions for the zero bits, before they reach
+// a release. The secrets should span the whole range of weights, from nearly
+// all zero to nearly all one bits, and op should do as little as possible
+// besides the operation under test.
+func TimingLeakCheck(t *testing.T, op func(secret []byte), secrets [][]byte) {
+	if len(secrets) < 2 {
+		t.Fatal("timing leak check needs at least two secrets")
+	}
+	if diff := timingLeak(op, secrets, timingLeakRounds); diff > timingLeakThreshold {
+		t.Errorf("running time depends on the Hamming weight of the secret: "+
+			"medians differ by %.0f%%", 100*diff)
+	}
+}
+
+// timingLeak returns the relative difference between the median running times
+// of op over the low and high Hamming weight halves of the secrets.
+func timingLeak(op func(secret []byte), secrets [][]byte, rounds int) float64 {
+	byWeight := make(hammingOrder, len(secrets))
+	copy(byWeight, secrets)
+	sort.Stable(byWeight)
+	half := len(byWeight) / 2
+	low, high := byWeight[:half], byWeight[len(byWeight)-half:]
+
+	// warm up caches and lazily initialized tables
+	op(low[0])
+	op(high[0])
+
+	var lowTimes, highTimes []float64
+	for r := 0; r < rounds; r++ {
+		for i := 0; i < half; i++ {
+			lowTimes = append(lowTimes, timeOp(op, low[i]))
+			highTimes = append(highTimes, timeOp(op, high[i]))
+		}
+	}
+	ml, mh := median(lowTimes), median(highTimes)
+	diff, min := mh-ml, ml
+	if diff < 0 {
+		diff, min = -diff, mh
+	}
+	if min <= 0 {
+		min = 1
+	}
+	return diff / min
+}
+
+func timeOp(op func(secret []byte), secret []byte) float64 {
+	start := time.Now()
+	op(secret)
+	return float64(time.Since(start))
+}
+
+func median(xs []float64) float64 {
+	sort.Float64s(xs)
+	return xs[len(xs)/2]
+}
+
+// hammingOrder sorts secrets by increasing Hamming weight.
+type hammingOrder [][]byte
+
+func (h hammingOrder) Len() int           { return len(h) }
+func (h hammingOrder) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h hammingOrder) Less(i, j int) bool { return hammingWeight(h[i]) < hammingWeight(h[j]) }
+
+func hammingWeight(b []byte) int {
+	w := 0
+	for _, x := range b {
+		for ; x != 0; x &= x - 1 {
+			w++
+		}
+	}
+	return w
+}
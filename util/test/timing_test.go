@@ -0,0 +1,40 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHammingWeight(t *testing.T) {
+	require.Equal(t, 0, hammingWeight([]byte{0, 0}))
+	require.Equal(t, 9, hammingWeight([]byte{0xff, 0x10}))
+}
+
+func TestTimingLeakDetected(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing measurements skipped in short mode")
+	}
+	// an operation working for every bit set leaks the Hamming weight
+	leaky := func(secret []byte) {
+		for i := 0; i < hammingWeight(secret); i++ {
+			spin(20000)
+		}
+	}
+	secrets := [][]byte{{0x01, 0x00}, {0xff, 0xff}, {0x00, 0x10}, {0xff, 0xfe}}
+	require.True(t, timingLeak(leaky, secrets, 3) > timingLeakThreshold)
+
+	// while a constant one doesn't
+	constant := func(secret []byte) {
+		spin(100000)
+	}
+	TimingLeakCheck(t, constant, secrets)
+}
+
+var spinSink int
+
+func spin(n int) {
+	for i := 0; i < n; i++ {
+		spinSink += i
+	}
+}